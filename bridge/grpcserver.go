@@ -0,0 +1,341 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/zionbridgerpc"
+)
+
+// grpcServer implements zionbridgerpc.ZionBridgeServer on top of
+// ZionLightningBridge, so the typed gRPC surface and the grpc-gateway REST
+// proxy generated from it share the exact same logic the Gin handlers used
+// to run directly.
+type grpcServer struct {
+	zionbridgerpc.UnimplementedZionBridgeServer
+
+	zlb *ZionLightningBridge
+}
+
+func newGRPCServer(zlb *ZionLightningBridge) *grpcServer {
+	return &grpcServer{zlb: zlb}
+}
+
+func (s *grpcServer) GetNodeInfo(ctx context.Context, _ *zionbridgerpc.GetNodeInfoRequest) (*zionbridgerpc.NodeInfo, error) {
+	info, err := s.zlb.GetNodeInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return marshalNodeInfo(info), nil
+}
+
+func (s *grpcServer) ListChannels(ctx context.Context, _ *zionbridgerpc.ListChannelsRequest) (*zionbridgerpc.ListChannelsResponse, error) {
+	channels, err := s.zlb.GetChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &zionbridgerpc.ListChannelsResponse{Channels: marshalChannels(channels)}, nil
+}
+
+func (s *grpcServer) CreateInvoice(ctx context.Context, req *zionbridgerpc.CreateInvoiceRequest) (*zionbridgerpc.Invoice, error) {
+	payment, err := s.zlb.CreateInvoice(ctx, InvoiceRequest{
+		Amount:      req.Amount,
+		AmountMsat:  req.AmountMsat,
+		Memo:        req.Memo,
+		ZionAddress: req.ZionAddress,
+		CallbackURL: req.CallbackUrl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return marshalInvoice(payment), nil
+}
+
+func (s *grpcServer) GetInvoice(_ context.Context, req *zionbridgerpc.GetInvoiceRequest) (*zionbridgerpc.Invoice, error) {
+	payment, ok := s.zlb.invoices.Get(req.PaymentHash)
+	if !ok {
+		return nil, fmt.Errorf("unknown payment hash")
+	}
+	return marshalInvoice(payment), nil
+}
+
+func (s *grpcServer) WaitInvoice(ctx context.Context, req *zionbridgerpc.WaitInvoiceRequest) (*zionbridgerpc.Invoice, error) {
+	payment, err := s.zlb.invoices.Wait(ctx, req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+	return marshalInvoice(payment), nil
+}
+
+func (s *grpcServer) SubscribeInvoices(_ *zionbridgerpc.SubscribeInvoicesRequest, stream zionbridgerpc.ZionBridge_SubscribeInvoicesServer) error {
+	updates, unsubscribe := s.zlb.invoices.Subscribe()
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case payment := <-updates:
+			if err := stream.Send(marshalInvoice(payment)); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *grpcServer) PayInvoice(ctx context.Context, req *zionbridgerpc.PayInvoiceRequest) (*zionbridgerpc.Swap, error) {
+	swap, err := s.zlb.swaps.InitiateZionToLN(ctx, req.Invoice, req.ZionAddress)
+	if err != nil {
+		return nil, err
+	}
+	return marshalSwap(swap), nil
+}
+
+func (s *grpcServer) ListSwaps(_ context.Context, _ *zionbridgerpc.ListSwapsRequest) (*zionbridgerpc.ListSwapsResponse, error) {
+	swaps := s.zlb.swaps.List()
+	out := make([]*zionbridgerpc.Swap, len(swaps))
+	for i, swap := range swaps {
+		out[i] = marshalSwap(swap)
+	}
+	return &zionbridgerpc.ListSwapsResponse{Swaps: out}, nil
+}
+
+func (s *grpcServer) GetSwap(_ context.Context, req *zionbridgerpc.GetSwapRequest) (*zionbridgerpc.Swap, error) {
+	swap, ok := s.zlb.swaps.Get(req.Id)
+	if !ok {
+		return nil, fmt.Errorf("unknown swap id")
+	}
+	return marshalSwap(swap), nil
+}
+
+func (s *grpcServer) SubscribeSwaps(_ *zionbridgerpc.SubscribeSwapsRequest, stream zionbridgerpc.ZionBridge_SubscribeSwapsServer) error {
+	updates, unsubscribe := s.zlb.swaps.Subscribe()
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case swap := <-updates:
+			if err := stream.Send(marshalSwap(swap)); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *grpcServer) OpenChannel(req *zionbridgerpc.OpenChannelRequest, stream zionbridgerpc.ZionBridge_OpenChannelServer) error {
+	peer, err := route.NewVertexFromStr(req.NodePubkey)
+	if err != nil {
+		return fmt.Errorf("invalid node_pubkey: %v", err)
+	}
+
+	ctx := stream.Context()
+	updates, errs, err := s.zlb.lnd.Client.OpenChannelStream(
+		ctx, peer, btcutil.Amount(req.LocalFundingAmount), btcutil.Amount(req.PushSat), false,
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			out, err := marshalOpenStatusUpdate(update)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(out); err != nil {
+				return err
+			}
+		case err := <-errs:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *grpcServer) CloseChannel(req *zionbridgerpc.CloseChannelRequest, stream zionbridgerpc.ZionBridge_CloseChannelServer) error {
+	chanPoint, err := parseChannelPoint(req.ChannelPoint)
+	if err != nil {
+		return fmt.Errorf("invalid channel_point: %v", err)
+	}
+
+	ctx := stream.Context()
+	updates, errs, err := s.zlb.lnd.Client.CloseChannel(ctx, chanPoint, req.Force, 0, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(marshalCloseStatusUpdate(update)); err != nil {
+				return err
+			}
+		case err := <-errs:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func marshalNodeInfo(info *NodeInfo) *zionbridgerpc.NodeInfo {
+	return &zionbridgerpc.NodeInfo{
+		PubKey:      info.PubKey,
+		Alias:       info.Alias,
+		NumChannels: info.NumChannels,
+		Capacity:    info.Capacity,
+		Synced:      info.Synced,
+		Testnet:     info.Testnet,
+		Channels:    marshalChannels(info.Channels),
+	}
+}
+
+func marshalChannels(channels []Channel) []*zionbridgerpc.ChannelInfo {
+	out := make([]*zionbridgerpc.ChannelInfo, len(channels))
+	for i, ch := range channels {
+		out[i] = &zionbridgerpc.ChannelInfo{
+			ChannelId:         ch.ChannelID,
+			RemoteNodeId:      ch.RemoteNodeID,
+			Capacity:          ch.Capacity,
+			LocalBalance:      ch.LocalBalance,
+			RemoteBalance:     ch.RemoteBalance,
+			Active:            ch.Active,
+			CapacityMsat:      ch.CapacityMsat,
+			LocalBalanceMsat:  ch.LocalBalanceMsat,
+			RemoteBalanceMsat: ch.RemoteBalanceMsat,
+		}
+	}
+	return out
+}
+
+func marshalInvoice(payment *LightningPayment) *zionbridgerpc.Invoice {
+	return &zionbridgerpc.Invoice{
+		Invoice:     payment.Invoice,
+		Amount:      payment.Amount,
+		AmountMsat:  payment.AmountMsat,
+		ZionTxHash:  payment.ZionTxHash,
+		Status:      payment.Status,
+		Timestamp:   payment.Timestamp,
+		PaymentHash: payment.PaymentHash,
+		SettledAt:   payment.SettledAt,
+		ZionAddress: payment.ZionAddress,
+		CallbackUrl: payment.CallbackURL,
+	}
+}
+
+func marshalSwap(swap *Swap) *zionbridgerpc.Swap {
+	return &zionbridgerpc.Swap{
+		Id:          swap.ID,
+		Direction:   string(swap.Direction),
+		State:       string(swap.State),
+		Invoice:     swap.Invoice,
+		PaymentHash: swap.PaymentHash,
+		ZionAddress: swap.ZionAddress,
+		AmountSat:   swap.AmountSat,
+		CreatedAt:   swap.CreatedAt,
+		UpdatedAt:   swap.UpdatedAt,
+		Error:       swap.Error,
+	}
+}
+
+func marshalOpenStatusUpdate(update *lndclient.OpenStatusUpdate) (*zionbridgerpc.OpenStatusUpdate, error) {
+	switch {
+	case update.ChanPending != nil:
+		return &zionbridgerpc.OpenStatusUpdate{
+			Update: &zionbridgerpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &zionbridgerpc.PendingUpdate{
+					Txid:        update.ChanPending.Txid,
+					OutputIndex: update.ChanPending.OutputIndex,
+				},
+			},
+		}, nil
+	case update.ChanOpen != nil:
+		point, err := marshalChannelPoint(update.ChanOpen.ChannelPoint)
+		if err != nil {
+			return nil, err
+		}
+		return &zionbridgerpc.OpenStatusUpdate{
+			Update: &zionbridgerpc.OpenStatusUpdate_ChanOpen{
+				ChanOpen: &zionbridgerpc.ChannelOpenUpdate{ChannelPoint: point},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized open channel update")
+	}
+}
+
+func marshalCloseStatusUpdate(update lndclient.CloseChannelUpdate) *zionbridgerpc.CloseStatusUpdate {
+	txid := update.CloseTxid()
+	switch update.(type) {
+	case *lndclient.ChannelClosedUpdate:
+		return &zionbridgerpc.CloseStatusUpdate{
+			Update: &zionbridgerpc.CloseStatusUpdate_ChanClose{
+				ChanClose: &zionbridgerpc.ChannelCloseUpdate{ClosingTxid: txid.String()},
+			},
+		}
+	default:
+		return &zionbridgerpc.CloseStatusUpdate{
+			Update: &zionbridgerpc.CloseStatusUpdate_ClosePending{
+				ClosePending: &zionbridgerpc.PendingUpdate{Txid: txid[:]},
+			},
+		}
+	}
+}
+
+// marshalChannelPoint formats an lnrpc.ChannelPoint as "txid:index", the
+// same shape parseChannelPoint accepts back for CloseChannel.
+func marshalChannelPoint(point *lnrpc.ChannelPoint) (string, error) {
+	var txid string
+	switch t := point.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		txid = t.FundingTxidStr
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		hash, err := chainhash.NewHash(t.FundingTxidBytes)
+		if err != nil {
+			return "", err
+		}
+		txid = hash.String()
+	default:
+		return "", fmt.Errorf("channel point has no funding txid")
+	}
+	return fmt.Sprintf("%s:%d", txid, point.OutputIndex), nil
+}
+
+// parseChannelPoint parses the "txid:index" form used throughout lnd's own
+// REST API back into a wire.OutPoint.
+func parseChannelPoint(s string) (*wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected txid:index, got %q", s)
+	}
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &wire.OutPoint{Hash: *hash, Index: uint32(index)}, nil
+}
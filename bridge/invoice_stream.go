@@ -0,0 +1,340 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// invoiceCheckpoint is the on-disk record of how far we've read the LND
+// invoice event stream, so a restart resumes instead of re-processing (or
+// missing) settlement events. Credited tracks which payment hashes have
+// already triggered a ZION credit, since a restart empties the in-memory
+// byHash index but LND's add stream still re-delivers already-settled
+// invoices at their current state -- without this, handleUpdate would
+// credit the same settlement twice.
+type invoiceCheckpoint struct {
+	AddIndex    uint64          `json:"add_index"`
+	SettleIndex uint64          `json:"settle_index"`
+	Credited    map[string]bool `json:"credited"`
+}
+
+// InvoiceTracker subscribes to lnrpc.SubscribeInvoices for the lifetime of
+// the bridge process, keeps an in-memory index of invoices keyed by payment
+// hash, and fans settlement events out to long-pollers and per-invoice
+// webhooks. On settlement it also triggers the ZION-side credit, so
+// incoming Lightning payments actually mint ZION instead of being
+// fire-and-forget.
+type InvoiceTracker struct {
+	bridge         *ZionLightningBridge
+	checkpointPath string
+	webhookSecret  string
+
+	mu          sync.RWMutex
+	byHash      map[string]*LightningPayment
+	waiters     map[string][]chan *LightningPayment
+	subscribers map[chan *LightningPayment]struct{}
+
+	checkpoint invoiceCheckpoint
+}
+
+// NewInvoiceTracker creates a tracker bound to the given bridge. checkpointPath
+// is where the add/settle index is persisted between restarts; webhookSecret
+// is used to HMAC-sign outbound callback POSTs.
+func NewInvoiceTracker(bridge *ZionLightningBridge, checkpointPath, webhookSecret string) *InvoiceTracker {
+	return &InvoiceTracker{
+		bridge:         bridge,
+		checkpointPath: checkpointPath,
+		webhookSecret:  webhookSecret,
+		byHash:         make(map[string]*LightningPayment),
+		waiters:        make(map[string][]chan *LightningPayment),
+		subscribers:    make(map[chan *LightningPayment]struct{}),
+		checkpoint:     invoiceCheckpoint{Credited: make(map[string]bool)},
+	}
+}
+
+// Subscribe registers a channel that receives every invoice update the
+// tracker observes (not just settlement/expiry), for streaming RPCs such as
+// SubscribeInvoices. The returned func unregisters it; callers must call it
+// when done to avoid leaking the channel.
+func (it *InvoiceTracker) Subscribe() (<-chan *LightningPayment, func()) {
+	ch := make(chan *LightningPayment, 16)
+
+	it.mu.Lock()
+	it.subscribers[ch] = struct{}{}
+	it.mu.Unlock()
+
+	unsubscribe := func() {
+		it.mu.Lock()
+		delete(it.subscribers, ch)
+		it.mu.Unlock()
+	}
+	return ch, unsubscribe
+}
+
+func (it *InvoiceTracker) broadcast(payment *LightningPayment) {
+	it.mu.RLock()
+	defer it.mu.RUnlock()
+
+	for ch := range it.subscribers {
+		select {
+		case ch <- payment:
+		default:
+			log.Printf("Warning: invoice subscriber channel full, dropping update for %s", payment.PaymentHash)
+		}
+	}
+}
+
+// Start loads the persisted checkpoint (if any) and launches the
+// SubscribeInvoices loop in the background.
+func (it *InvoiceTracker) Start(ctx context.Context) error {
+	it.loadCheckpoint()
+	go it.subscribeLoop(ctx)
+	return nil
+}
+
+// Register adds a freshly-created invoice to the in-memory index so it can
+// be polled/waited on before LND reports any update for it.
+func (it *InvoiceTracker) Register(payment *LightningPayment) {
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.byHash[payment.PaymentHash] = payment
+}
+
+// Get returns a snapshot of an invoice's current tracked state. It returns a
+// copy rather than the tracker's own pointer, since that pointer keeps being
+// mutated by handleUpdate for as long as the invoice is in flight.
+func (it *InvoiceTracker) Get(hash string) (*LightningPayment, bool) {
+	it.mu.RLock()
+	defer it.mu.RUnlock()
+	payment, ok := it.byHash[hash]
+	if !ok {
+		return nil, false
+	}
+	snapshot := *payment
+	return &snapshot, true
+}
+
+// Wait blocks until the invoice identified by hash settles or expires, or
+// ctx is cancelled.
+func (it *InvoiceTracker) Wait(ctx context.Context, hash string) (*LightningPayment, error) {
+	it.mu.Lock()
+	if payment, ok := it.byHash[hash]; ok && payment.Status != "pending" {
+		snapshot := *payment
+		it.mu.Unlock()
+		return &snapshot, nil
+	}
+	ch := make(chan *LightningPayment, 1)
+	it.waiters[hash] = append(it.waiters[hash], ch)
+	it.mu.Unlock()
+
+	select {
+	case payment := <-ch:
+		return payment, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// subscribeLoop opens the LND invoice event stream and never returns
+// (other than on ctx cancellation); it reconnects after a fixed 5s pause on error so
+// a transient LND restart doesn't take the tracker down with it.
+func (it *InvoiceTracker) subscribeLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		it.mu.RLock()
+		req := &lnrpc.InvoiceSubscription{
+			AddIndex:    it.checkpoint.AddIndex,
+			SettleIndex: it.checkpoint.SettleIndex,
+		}
+		it.mu.RUnlock()
+
+		stream, err := it.bridge.rawClient.SubscribeInvoices(ctx, req)
+		if err != nil {
+			log.Printf("Warning: SubscribeInvoices failed, retrying in 5s: %v", err)
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				log.Printf("Warning: invoice stream closed, reconnecting: %v", err)
+				break
+			}
+			it.handleUpdate(update)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (it *InvoiceTracker) handleUpdate(update *lnrpc.Invoice) {
+	hash := hex.EncodeToString(update.RHash)
+
+	it.mu.Lock()
+	payment, ok := it.byHash[hash]
+	if !ok {
+		payment = &LightningPayment{
+			Invoice:     update.PaymentRequest,
+			Amount:      uint64(update.Value),
+			PaymentHash: hash,
+			Timestamp:   time.Now().Unix(),
+		}
+		it.byHash[hash] = payment
+	}
+
+	switch update.State {
+	case lnrpc.Invoice_SETTLED:
+		payment.Status = "settled"
+		payment.SettledAt = time.Now().Unix()
+	case lnrpc.Invoice_CANCELED:
+		payment.Status = "expired"
+	default:
+		payment.Status = "pending"
+	}
+
+	// update.AddIndex/SettleIndex are the index of *this* invoice, not a
+	// running high-water mark -- a settle notification in particular
+	// carries the invoice's original (older) add_index, so these must only
+	// ever move forward, never jump back to whatever this one event
+	// happens to report.
+	if update.AddIndex > it.checkpoint.AddIndex {
+		it.checkpoint.AddIndex = update.AddIndex
+	}
+	if update.SettleIndex > it.checkpoint.SettleIndex {
+		it.checkpoint.SettleIndex = update.SettleIndex
+	}
+	it.saveCheckpoint()
+
+	alreadyCredited := it.checkpoint.Credited[hash]
+	_, swapManaged := it.bridge.swaps.Get(hash)
+
+	waiters := it.waiters[hash]
+	delete(it.waiters, hash)
+	snapshot := *payment
+	it.mu.Unlock()
+
+	it.broadcast(&snapshot)
+
+	if snapshot.Status == "settled" || snapshot.Status == "expired" {
+		for _, ch := range waiters {
+			ch <- &snapshot
+		}
+	}
+
+	if snapshot.Status != "settled" {
+		return
+	}
+
+	// swapManaged invoices (minted via InitiateLNToZion) credit ZION
+	// atomically as part of accepting the HTLC, before this SETTLED event
+	// even fires -- crediting them again here would double-pay. alreadyCredited
+	// is this fire-and-forget path's own persisted guard against the same
+	// mistake across a restart, since LND's add stream re-delivers
+	// already-settled invoices at their current state.
+	if snapshot.ZionAddress != "" && !swapManaged && !alreadyCredited {
+		amtZion, err := it.bridge.ConvertSatToZion(context.Background(), snapshot.Amount)
+		if err != nil {
+			log.Printf("Warning: invoice %s settled but ZION conversion failed: %v", hash, err)
+		} else if err := it.bridge.zionRPC.SendTransaction("lightning_pool_address", snapshot.ZionAddress, amtZion); err != nil {
+			log.Printf("Warning: invoice %s settled but ZION credit failed: %v", hash, err)
+		} else {
+			it.mu.Lock()
+			payment.Status = "credited"
+			it.checkpoint.Credited[hash] = true
+			it.saveCheckpoint()
+			it.mu.Unlock()
+			snapshot.Status = "credited"
+			it.broadcast(&snapshot)
+		}
+	}
+
+	if snapshot.CallbackURL != "" {
+		go it.dispatchWebhook(&snapshot)
+	}
+}
+
+// dispatchWebhook POSTs the settled payment to the invoice's registered
+// callback URL, signing the body with HMAC-SHA256 so the receiver can
+// authenticate it came from this bridge.
+func (it *InvoiceTracker) dispatchWebhook(payment *LightningPayment) {
+	body, err := json.Marshal(payment)
+	if err != nil {
+		log.Printf("Warning: cannot marshal webhook payload for %s: %v", payment.PaymentHash, err)
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, payment.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		log.Printf("Warning: cannot build webhook request for %s: %v", payment.PaymentHash, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Zion-Signature", it.signWebhook(body))
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		log.Printf("Warning: webhook delivery failed for %s: %v", payment.PaymentHash, err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		log.Printf("Warning: webhook for %s returned status %d", payment.PaymentHash, resp.StatusCode)
+	}
+}
+
+func (it *InvoiceTracker) signWebhook(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(it.webhookSecret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (it *InvoiceTracker) loadCheckpoint() {
+	data, err := ioutil.ReadFile(it.checkpointPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Warning: cannot read invoice checkpoint: %v", err)
+		}
+		return
+	}
+	if err := json.Unmarshal(data, &it.checkpoint); err != nil {
+		log.Printf("Warning: cannot parse invoice checkpoint: %v", err)
+	}
+	if it.checkpoint.Credited == nil {
+		it.checkpoint.Credited = make(map[string]bool)
+	}
+}
+
+func (it *InvoiceTracker) saveCheckpoint() {
+	data, err := json.Marshal(it.checkpoint)
+	if err != nil {
+		log.Printf("Warning: cannot marshal invoice checkpoint: %v", err)
+		return
+	}
+	if err := ioutil.WriteFile(it.checkpointPath, data, 0644); err != nil {
+		log.Printf("Warning: cannot persist invoice checkpoint: %v", err)
+	}
+}
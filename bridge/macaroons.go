@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/pkg/lsat"
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/pkg/macaroons"
+)
+
+const macaroonMetadataKey = "macaroon"
+
+// bridgeRPCPermissions maps each ZionBridge gRPC method's full name to the
+// entity:action permissions a macaroon must carry to call it, mirroring
+// lnd's own per-RPC permission tables.
+var bridgeRPCPermissions = map[string][]macaroons.Permission{
+	"/zionbridgerpc.ZionBridge/GetNodeInfo":       {{Entity: macaroons.EntityNode, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/ListChannels":      {{Entity: macaroons.EntityChannel, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/CreateInvoice":     {{Entity: macaroons.EntityInvoice, Action: macaroons.ActionWrite}},
+	"/zionbridgerpc.ZionBridge/GetInvoice":        {{Entity: macaroons.EntityInvoice, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/WaitInvoice":       {{Entity: macaroons.EntityInvoice, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/SubscribeInvoices": {{Entity: macaroons.EntityInvoice, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/PayInvoice":        {{Entity: macaroons.EntityOffchain, Action: macaroons.ActionWrite}},
+	"/zionbridgerpc.ZionBridge/ListSwaps":         {{Entity: macaroons.EntityOffchain, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/GetSwap":           {{Entity: macaroons.EntityOffchain, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/SubscribeSwaps":    {{Entity: macaroons.EntityOffchain, Action: macaroons.ActionRead}},
+	"/zionbridgerpc.ZionBridge/OpenChannel":       {{Entity: macaroons.EntityChannel, Action: macaroons.ActionWrite}},
+	"/zionbridgerpc.ZionBridge/CloseChannel":      {{Entity: macaroons.EntityChannel, Action: macaroons.ActionWrite}},
+}
+
+// bridgeRoutePermissions maps each REST route (keyed the same way the LSAT
+// price table is) to the permissions required of the gRPC method backing
+// it, so the Gin-level macaroon check and the gRPC interceptor enforce
+// identical policy for the two ways of reaching the same handler.
+var bridgeRoutePermissions = map[string][]macaroons.Permission{
+	lsat.RouteKey("GET", "/api/v1/node/info"):          bridgeRPCPermissions["/zionbridgerpc.ZionBridge/GetNodeInfo"],
+	lsat.RouteKey("GET", "/api/v1/channels"):           bridgeRPCPermissions["/zionbridgerpc.ZionBridge/ListChannels"],
+	lsat.RouteKey("POST", "/api/v1/invoice"):           bridgeRPCPermissions["/zionbridgerpc.ZionBridge/CreateInvoice"],
+	lsat.RouteKey("GET", "/api/v1/invoice/:hash"):      bridgeRPCPermissions["/zionbridgerpc.ZionBridge/GetInvoice"],
+	lsat.RouteKey("GET", "/api/v1/invoice/:hash/wait"): bridgeRPCPermissions["/zionbridgerpc.ZionBridge/WaitInvoice"],
+	lsat.RouteKey("GET", "/api/v1/invoices/subscribe"): bridgeRPCPermissions["/zionbridgerpc.ZionBridge/SubscribeInvoices"],
+	lsat.RouteKey("POST", "/api/v1/pay"):               bridgeRPCPermissions["/zionbridgerpc.ZionBridge/PayInvoice"],
+	lsat.RouteKey("GET", "/api/v1/swaps"):              bridgeRPCPermissions["/zionbridgerpc.ZionBridge/ListSwaps"],
+	lsat.RouteKey("GET", "/api/v1/swaps/:id"):          bridgeRPCPermissions["/zionbridgerpc.ZionBridge/GetSwap"],
+	lsat.RouteKey("GET", "/api/v1/swaps/subscribe"):    bridgeRPCPermissions["/zionbridgerpc.ZionBridge/SubscribeSwaps"],
+	lsat.RouteKey("POST", "/api/v1/channels/open"):     bridgeRPCPermissions["/zionbridgerpc.ZionBridge/OpenChannel"],
+	lsat.RouteKey("POST", "/api/v1/channels/close"):    bridgeRPCPermissions["/zionbridgerpc.ZionBridge/CloseChannel"],
+}
+
+// bakedMacaroons holds the three standard macaroons minted on first
+// startup: admin (every permission), invoice (invoice read/write only, for
+// services that just create/watch invoices) and readonly (every :read
+// permission, no writes). paymentLimited is only minted when the operator
+// configures a cap (paymentLimitMsat > 0 in bakeMacaroonFiles): it carries
+// the same offchain:write permission PayInvoice requires, plus a
+// max-payment-msat caveat, for handing out to callers that should only ever
+// move small amounts.
+type bakedMacaroons struct {
+	admin          []byte
+	invoice        []byte
+	readonly       []byte
+	paymentLimited []byte
+}
+
+// allPermissions is the union of every entity:action pair any RPC requires,
+// i.e. what the admin macaroon is baked with.
+func allPermissions() []macaroons.Permission {
+	seen := make(map[macaroons.Permission]struct{})
+	var out []macaroons.Permission
+	for _, perms := range bridgeRPCPermissions {
+		for _, p := range perms {
+			if _, ok := seen[p]; !ok {
+				seen[p] = struct{}{}
+				out = append(out, p)
+			}
+		}
+	}
+	return out
+}
+
+func readonlyPermissions() []macaroons.Permission {
+	var out []macaroons.Permission
+	for _, p := range allPermissions() {
+		if p.Action == macaroons.ActionRead {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// bakeMacaroonFiles mints admin.macaroon/invoice.macaroon/readonly.macaroon
+// under dir on first run, loading whatever's already on disk on subsequent
+// ones so a restart doesn't churn out fresh tokens (and invalidate the ones
+// operators have already handed out) every time the bridge boots. When
+// paymentLimitMsat is non-zero, it also mints payment-limited.macaroon,
+// scoped to PayInvoice and capped by a max-payment-msat caveat of that
+// amount -- the one caveat-scoped macaroon this bridge knows how to bake,
+// for operators who want to hand out a token that can't move more than a
+// fixed amount per payment.
+func bakeMacaroonFiles(ctx context.Context, svc *macaroons.Service, dir string, paymentLimitMsat uint64) (*bakedMacaroons, error) {
+	bake := func(filename string, maxPaymentMsat uint64, perms []macaroons.Permission) ([]byte, error) {
+		path := dir + "/" + filename
+		if data, err := os.ReadFile(path); err == nil {
+			return data, nil
+		} else if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("cannot read %s: %v", path, err)
+		}
+
+		mac, err := svc.Bake(ctx, time.Time{}, "", maxPaymentMsat, perms...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot bake %s: %v", filename, err)
+		}
+		data, err := mac.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal %s: %v", filename, err)
+		}
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			return nil, fmt.Errorf("cannot persist %s: %v", filename, err)
+		}
+		return data, nil
+	}
+
+	admin, err := bake("admin.macaroon", 0, allPermissions())
+	if err != nil {
+		return nil, err
+	}
+	invoice, err := bake("invoice.macaroon", 0, []macaroons.Permission{
+		{Entity: macaroons.EntityInvoice, Action: macaroons.ActionRead},
+		{Entity: macaroons.EntityInvoice, Action: macaroons.ActionWrite},
+	})
+	if err != nil {
+		return nil, err
+	}
+	readonly, err := bake("readonly.macaroon", 0, readonlyPermissions())
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentLimited []byte
+	if paymentLimitMsat > 0 {
+		paymentLimited, err = bake("payment-limited.macaroon", paymentLimitMsat, []macaroons.Permission{
+			{Entity: macaroons.EntityOffchain, Action: macaroons.ActionWrite},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &bakedMacaroons{admin: admin, invoice: invoice, readonly: readonly, paymentLimited: paymentLimited}, nil
+}
+
+// openMacaroonService opens (creating if necessary) the bbolt-backed
+// macaroon root key store at dbPath and returns the bakery Service built on
+// top of it.
+func openMacaroonService(dbPath string) (*macaroons.Service, error) {
+	db, err := bbolt.Open(dbPath, 0600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open macaroon database: %v", err)
+	}
+	return macaroons.NewService(db, "zion-lightning-bridge")
+}
+
+// unaryMacaroonInterceptor enforces bridgeRPCPermissions on every unary RPC,
+// extracting the macaroon from the "macaroon" gRPC metadata key -- which is
+// what grpc-gateway forwards a Grpc-Metadata-Macaroon REST header as, and
+// what a native gRPC client sets directly.
+func unaryMacaroonInterceptor(svc *macaroons.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorizeRPC(ctx, svc, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamMacaroonInterceptor is the streaming-RPC equivalent of
+// unaryMacaroonInterceptor.
+func streamMacaroonInterceptor(svc *macaroons.Service) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorizeRPC(ss.Context(), svc, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &macaroonServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// macaroonServerStream overrides Context() so downstream handlers observe
+// the client-IP-annotated context authorizeRPC built, the same way a unary
+// call would.
+type macaroonServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *macaroonServerStream) Context() context.Context { return s.ctx }
+
+// authorizeRPC runs the interceptor's amount-blind authorization pass: it
+// checks the macaroon grants fullMethod's entity:action permissions, and
+// attaches both the client IP (for an ip-range caveat) and the raw macaroon
+// bytes to the returned context. RPCs whose amount isn't known until the
+// handler runs its own logic (PayInvoice) use authorizeAmount on that
+// stashed macaroon for a second, amount-aware pass once it is.
+func authorizeRPC(ctx context.Context, svc *macaroons.Service, fullMethod string) (context.Context, error) {
+	perms, ok := bridgeRPCPermissions[fullMethod]
+	if !ok {
+		return ctx, fmt.Errorf("no permissions registered for method %s", fullMethod)
+	}
+
+	macHex, err := macaroonFromMetadata(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	macBytes, err := hex.DecodeString(macHex)
+	if err != nil {
+		return ctx, fmt.Errorf("invalid macaroon encoding: %v", err)
+	}
+	ctx = macaroons.ContextWithMacaroon(ctx, macBytes)
+
+	if p, ok := peer.FromContext(ctx); ok {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			ctx = macaroons.ContextWithClientIP(ctx, host)
+		}
+	}
+
+	if err := svc.Authorize(ctx, macBytes, perms...); err != nil {
+		return ctx, fmt.Errorf("macaroon authorization failed: %v", err)
+	}
+	return ctx, nil
+}
+
+// authorizeAmount re-runs macaroon authorization for perms with amtMsat
+// attached via ContextWithPaymentMsat, using the macaroon authorizeRPC
+// stashed on ctx. It's the second, amount-aware check PayInvoice needs: the
+// actual amount is only known once InitiateZionToLN has decoded the
+// invoice, after the interceptor's first pass already ran without it.
+func authorizeAmount(ctx context.Context, svc *macaroons.Service, amtMsat uint64, perms ...macaroons.Permission) error {
+	macBytes, ok := macaroons.MacaroonFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("no macaroon available to check payment amount")
+	}
+	ctx = macaroons.ContextWithPaymentMsat(ctx, amtMsat)
+	if err := svc.Authorize(ctx, macBytes, perms...); err != nil {
+		return fmt.Errorf("macaroon authorization failed: %v", err)
+	}
+	return nil
+}
+
+func macaroonFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("no macaroon provided")
+	}
+	vals := md.Get(macaroonMetadataKey)
+	if len(vals) == 0 || vals[0] == "" {
+		return "", fmt.Errorf("no macaroon provided")
+	}
+	return vals[0], nil
+}
+
+// macaroonMiddleware enforces bridgeRoutePermissions against the macaroon a
+// REST client presents via the Grpc-Metadata-Macaroon header (preferred, to
+// match gRPC clients) or, failing that, the Authorization header -- as long
+// as it isn't an LSAT token, which uses that same header for a different,
+// payment-gated purpose. Routes with no entry in bridgeRoutePermissions are
+// left unprotected.
+func macaroonMiddleware(svc *macaroons.Service) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		perms, protected := bridgeRoutePermissions[lsat.RouteKey(c.Request.Method, c.FullPath())]
+		if !protected {
+			c.Next()
+			return
+		}
+
+		macHex, ok := macaroonHexFromRequest(c)
+		if !ok {
+			c.AbortWithStatusJSON(401, gin.H{"error": "macaroon required"})
+			return
+		}
+		macBytes, err := hex.DecodeString(macHex)
+		if err != nil {
+			c.AbortWithStatusJSON(401, gin.H{"error": "invalid macaroon encoding"})
+			return
+		}
+
+		ctx := macaroons.ContextWithClientIP(c.Request.Context(), c.ClientIP())
+		if err := svc.Authorize(ctx, macBytes, perms...); err != nil {
+			c.AbortWithStatusJSON(401, gin.H{"error": fmt.Sprintf("macaroon authorization failed: %v", err)})
+			return
+		}
+
+		// The gRPC interceptor behind the gateway only reads the macaroon
+		// from Grpc-Metadata-Macaroon, so one given via Authorization is
+		// passed on under that header as well.
+		c.Request.Header.Set("Grpc-Metadata-Macaroon", macHex)
+
+		c.Next()
+	}
+}
+
+func macaroonHexFromRequest(c *gin.Context) (string, bool) {
+	if v := c.GetHeader("Grpc-Metadata-Macaroon"); v != "" {
+		return v, true
+	}
+	if v := c.GetHeader("Authorization"); v != "" && !strings.HasPrefix(strings.ToUpper(v), "LSAT ") {
+		return strings.TrimPrefix(v, "Bearer "), true
+	}
+	return "", false
+}
@@ -3,57 +3,105 @@ package main
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
 	"fmt"
-	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"gopkg.in/macaroon.v2"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/pkg/amount"
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/pkg/lsat"
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/pkg/macaroons"
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/zionbridgerpc"
 )
 
 // ZionLightningBridge represents the main bridge service
 type ZionLightningBridge struct {
-	lndClient lnrpc.LightningClient
-	zionRPC   *ZionRPCClient
-	config    *Config
+	lnd          *lndclient.LndServices
+	rawClient    lnrpc.LightningClient
+	zionRPC      *ZionRPCClient
+	config       *Config
+	invoices     *InvoiceTracker
+	swaps        *SwapEngine
+	rateProvider amount.RateProvider
+
+	// macaroonSvc is set once main() opens it, after the bridge itself is
+	// constructed; it's nil until then, but nothing that runs before
+	// startGRPCServer calls InitiateZionToLN, which is the only caller
+	// that needs it (for the amount-aware max-payment-msat re-check).
+	macaroonSvc *macaroons.Service
 }
 
 // Config holds the bridge configuration
 type Config struct {
-	ZionRPCURL     string
-	LNDHost        string
-	LNDTLSCert     string
-	LNDMacaroon    string
-	BridgePort     string
-	LogLevel       string
+	ZionRPCURL        string
+	LNDHost           string
+	LNDNetwork        string
+	LNDTLSCert        string
+	LNDMacaroon       string
+	BridgePort        string
+	LogLevel          string
+	InvoiceIndexPath  string
+	WebhookHMACSecret string
+	SwapDBPath        string
+	LSATRootKeyPath   string
+	GRPCPort          string
+	GRPCTLSCertPath   string
+	GRPCTLSKeyPath    string
+	MacaroonDBPath    string
+	MacaroonDir       string
+	ZionPerSatRate    string
+	SwapSpreadBps     uint64
+	PayInvoiceMaxMsat uint64
 }
 
-// LightningPayment represents a Lightning Network payment
+// LightningPayment represents a Lightning Network payment. Amount is kept
+// in satoshis for backward compatibility with existing clients; AmountMsat
+// carries the same value at the millisatoshi precision LND itself tracks
+// internally, and is authoritative whenever the two could disagree.
 type LightningPayment struct {
 	Invoice     string `json:"invoice"`
 	Amount      uint64 `json:"amount"`
+	AmountMsat  uint64 `json:"amount_msat"`
 	ZionTxHash  string `json:"zion_tx_hash"`
 	Status      string `json:"status"`
 	Timestamp   int64  `json:"timestamp"`
 	PaymentHash string `json:"payment_hash"`
+	SettledAt   int64  `json:"settled_at,omitempty"`
+	ZionAddress string `json:"zion_address,omitempty"`
+	CallbackURL string `json:"callback_url,omitempty"`
 }
 
-// Channel represents a Lightning Network channel
+// Channel represents a Lightning Network channel. The *Msat fields carry
+// the same balances as their sat counterparts at millisatoshi precision;
+// lndclient itself only reports channel balances at satoshi granularity,
+// so today these are always exact multiples of 1000, but callers that need
+// msat precision (e.g. once sub-satoshi HTLCs are tracked) have a stable
+// field to read it from.
 type Channel struct {
-	ChannelID     string `json:"channel_id"`
-	RemoteNodeID  string `json:"remote_node_id"`
-	Capacity      uint64 `json:"capacity"`
-	LocalBalance  uint64 `json:"local_balance"`
-	RemoteBalance uint64 `json:"remote_balance"`
-	Active        bool   `json:"active"`
+	ChannelID         string `json:"channel_id"`
+	RemoteNodeID      string `json:"remote_node_id"`
+	Capacity          uint64 `json:"capacity"`
+	CapacityMsat      uint64 `json:"capacity_msat"`
+	LocalBalance      uint64 `json:"local_balance"`
+	LocalBalanceMsat  uint64 `json:"local_balance_msat"`
+	RemoteBalance     uint64 `json:"remote_balance"`
+	RemoteBalanceMsat uint64 `json:"remote_balance_msat"`
+	Active            bool   `json:"active"`
 }
 
 // NodeInfo represents Lightning Network node information
@@ -74,10 +122,16 @@ type PaymentRequest struct {
 	Amount      uint64 `json:"amount,omitempty"`
 }
 
-// InvoiceRequest represents an invoice creation request
+// InvoiceRequest represents an invoice creation request. AmountMsat, if
+// set, takes precedence over Amount and is used at full millisatoshi
+// precision; otherwise Amount (satoshis) is converted losslessly via
+// amount.Sat.ToMsat.
 type InvoiceRequest struct {
-	Amount uint64 `json:"amount"`
-	Memo   string `json:"memo"`
+	Amount      uint64 `json:"amount"`
+	AmountMsat  uint64 `json:"amount_msat,omitempty"`
+	Memo        string `json:"memo"`
+	ZionAddress string `json:"zion_address,omitempty"`
+	CallbackURL string `json:"callback_url,omitempty"`
 }
 
 // ZionRPCClient handles communication with ZION blockchain
@@ -109,15 +163,53 @@ func (zrc *ZionRPCClient) SendTransaction(from, to string, amount uint64) error
 	return nil
 }
 
+// ReserveBalance places a hold on amount ZION for address so it can't be
+// spent elsewhere while an outbound swap is in flight.
+func (zrc *ZionRPCClient) ReserveBalance(address string, amount uint64) error {
+	// TODO: Implement an actual balance-hold entry against ZION state.
+	// For now, just log the reservation.
+	log.Printf("ZION RESERVE: %s holds %d ZION", address, amount)
+	return nil
+}
+
+// ReleaseReservation lifts a hold placed by ReserveBalance, e.g. because the
+// Lightning payment it was backing failed.
+func (zrc *ZionRPCClient) ReleaseReservation(address string, amount uint64) error {
+	// TODO: Implement an actual reservation release.
+	log.Printf("ZION RELEASE: %s releases hold of %d ZION", address, amount)
+	return nil
+}
+
+// FinalizeReservation converts a held reservation into a real debit once the
+// Lightning leg of a swap has succeeded.
+func (zrc *ZionRPCClient) FinalizeReservation(address string, amount uint64) error {
+	// TODO: Implement an actual reservation debit.
+	log.Printf("ZION FINALIZE: %s debited %d ZION from reservation", address, amount)
+	return nil
+}
+
 // LoadConfig loads configuration from environment variables
 func LoadConfig() *Config {
 	return &Config{
-		ZionRPCURL:  getEnv("ZION_RPC_URL", "http://localhost:18089"),
-		LNDHost:     getEnv("LND_HOST", "localhost:10009"),
-		LNDTLSCert:  getEnv("LND_TLS_CERT_PATH", "/lnd-certs/tls.cert"),
-		LNDMacaroon: getEnv("LND_ADMIN_MACAROON_PATH", "/lnd-certs/admin.macaroon"),
-		BridgePort:  getEnv("BRIDGE_PORT", "8090"),
-		LogLevel:    getEnv("LOG_LEVEL", "info"),
+		ZionRPCURL:        getEnv("ZION_RPC_URL", "http://localhost:18089"),
+		LNDHost:           getEnv("LND_HOST", "localhost:10009"),
+		LNDNetwork:        getEnv("LND_NETWORK", "testnet"),
+		LNDTLSCert:        getEnv("LND_TLS_CERT_PATH", "/lnd-certs/tls.cert"),
+		LNDMacaroon:       getEnv("LND_ADMIN_MACAROON_PATH", "/lnd-certs/admin.macaroon"),
+		BridgePort:        getEnv("BRIDGE_PORT", "8090"),
+		LogLevel:          getEnv("LOG_LEVEL", "info"),
+		InvoiceIndexPath:  getEnv("INVOICE_INDEX_PATH", "/data/invoice-index.json"),
+		WebhookHMACSecret: getEnv("WEBHOOK_HMAC_SECRET", ""),
+		SwapDBPath:        getEnv("SWAP_DB_PATH", "/data/swaps.db"),
+		LSATRootKeyPath:   getEnv("LSAT_ROOT_KEY_PATH", "/data/lsat-root.key"),
+		GRPCPort:          getEnv("GRPC_PORT", "8091"),
+		GRPCTLSCertPath:   getEnv("GRPC_TLS_CERT_PATH", "/data/bridge-tls.cert"),
+		GRPCTLSKeyPath:    getEnv("GRPC_TLS_KEY_PATH", "/data/bridge-tls.key"),
+		MacaroonDBPath:    getEnv("MACAROON_DB_PATH", "/data/macaroons.db"),
+		MacaroonDir:       getEnv("MACAROON_DIR", "/data"),
+		ZionPerSatRate:    getEnv("ZION_PER_SAT_RATE", "1"),
+		SwapSpreadBps:     getEnvUint64("SWAP_SPREAD_BPS", 0),
+		PayInvoiceMaxMsat: getEnvUint64("PAY_INVOICE_MAX_MSAT", 0),
 	}
 }
 
@@ -128,64 +220,86 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
-// NewZionLightningBridge creates a new bridge instance
-func NewZionLightningBridge(config *Config) (*ZionLightningBridge, error) {
-	// Load TLS certificate
-	tlsCreds, err := credentials.NewClientTLSFromFile(config.LNDTLSCert, "")
+func getEnvUint64(key string, defaultValue uint64) uint64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseUint(value, 10, 64)
 	if err != nil {
-		// Try insecure connection for development
-		log.Printf("Warning: Could not load TLS cert, trying insecure connection: %v", err)
-		tlsCreds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+		log.Printf("Warning: invalid %s=%q, using default %d: %v", key, value, defaultValue, err)
+		return defaultValue
 	}
+	return parsed
+}
 
-	// Load macaroon
-	var creds credentials.PerRPCCredentials
-	if _, err := os.Stat(config.LNDMacaroon); err == nil {
-		macaroonBytes, err := ioutil.ReadFile(config.LNDMacaroon)
-		if err != nil {
-			return nil, fmt.Errorf("cannot read macaroon file: %v", err)
-		}
-		
-		mac := &macaroon.Macaroon{}
-		if err = mac.UnmarshalBinary(macaroonBytes); err != nil {
-			return nil, fmt.Errorf("cannot unmarshal macaroon: %v", err)
-		}
-		
-		creds = NewMacaroonCredential(mac)
-	} else {
-		log.Printf("Warning: Macaroon file not found, proceeding without auth: %v", err)
+// NewZionLightningBridge creates a new bridge instance. It connects to lnd
+// through lndclient, which bundles the Lightning, WalletKit, ChainNotifier,
+// Signer, Invoices, Router and Versioner clients behind a single typed
+// handle instead of the bridge dialing and authenticating gRPC itself.
+func NewZionLightningBridge(config *Config) (*ZionLightningBridge, error) {
+	services, err := lndclient.NewLndServices(&lndclient.LndServicesConfig{
+		LndAddress:         config.LNDHost,
+		Network:            lndclient.Network(config.LNDNetwork),
+		CustomMacaroonPath: config.LNDMacaroon,
+		TLSPath:            config.LNDTLSCert,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to lnd: %v", err)
 	}
 
-	// Setup gRPC connection options
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(tlsCreds),
+	// lndclient doesn't expose a multi-invoice SubscribeInvoices call (its
+	// Invoices client only subscribes per-hash), so the invoice tracker
+	// talks to the raw Lightning client over the same authenticated
+	// connection lndclient already dialed.
+	rawClient := lnrpc.NewLightningClient(services.ClientConn)
+
+	// Create ZION RPC client
+	zionRPC := NewZionRPCClient(config.ZionRPCURL)
+
+	rateProvider, err := amount.NewFixedRateProvider(config.ZionPerSatRate)
+	if err != nil {
+		return nil, fmt.Errorf("cannot init ZION conversion rate: %v", err)
 	}
-	
-	if creds != nil {
-		opts = append(opts, grpc.WithPerRPCCredentials(creds))
+
+	zlb := &ZionLightningBridge{
+		lnd:          &services.LndServices,
+		rawClient:    rawClient,
+		zionRPC:      zionRPC,
+		config:       config,
+		rateProvider: rateProvider,
 	}
+	zlb.invoices = NewInvoiceTracker(zlb, config.InvoiceIndexPath, config.WebhookHMACSecret)
 
-	// Connect to LND
-	conn, err := grpc.Dial(config.LNDHost, opts...)
+	swaps, err := NewSwapEngine(zlb, config.SwapDBPath)
 	if err != nil {
-		return nil, fmt.Errorf("cannot dial to lnd: %v", err)
+		return nil, fmt.Errorf("cannot init swap engine: %v", err)
 	}
+	zlb.swaps = swaps
 
-	lndClient := lnrpc.NewLightningClient(conn)
-
-	// Create ZION RPC client
-	zionRPC := NewZionRPCClient(config.ZionRPCURL)
+	return zlb, nil
+}
 
-	return &ZionLightningBridge{
-		lndClient: lndClient,
-		zionRPC:   zionRPC,
-		config:    config,
-	}, nil
+// ConvertSatToZion applies the bridge's configured exchange rate and spread
+// to a Lightning-side satoshi amount, returning the ZION atomic amount that
+// should actually move for it. Every code path that credits or debits ZION
+// for a Lightning amount goes through this instead of treating the two
+// units as equivalent.
+func (zlb *ZionLightningBridge) ConvertSatToZion(ctx context.Context, sat uint64) (uint64, error) {
+	rate, err := zlb.rateProvider.ZionPerSat(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot get ZION conversion rate: %v", err)
+	}
+	conv, err := amount.SatToZion(amount.Sat(sat), rate, zlb.config.SwapSpreadBps)
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %d sat to ZION: %v", sat, err)
+	}
+	return uint64(conv.Zion), nil
 }
 
 // GetNodeInfo retrieves Lightning Network node information
 func (zlb *ZionLightningBridge) GetNodeInfo(ctx context.Context) (*NodeInfo, error) {
-	info, err := zlb.lndClient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	info, err := zlb.lnd.Client.GetInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -202,33 +316,35 @@ func (zlb *ZionLightningBridge) GetNodeInfo(ctx context.Context) (*NodeInfo, err
 	}
 
 	return &NodeInfo{
-		PubKey:      info.IdentityPubkey,
+		PubKey:      hex.EncodeToString(info.IdentityPubkey[:]),
 		Alias:       info.Alias,
-		NumChannels: info.NumActiveChannels,
+		NumChannels: info.ActiveChannels,
 		Capacity:    totalCapacity,
 		Synced:      info.SyncedToChain,
-		Testnet:     info.Testnet,
+		Testnet:     info.Network == string(lndclient.NetworkTestnet),
 		Channels:    channels,
 	}, nil
 }
 
 // GetChannels retrieves all Lightning Network channels
 func (zlb *ZionLightningBridge) GetChannels(ctx context.Context) ([]Channel, error) {
-	channelsReq := &lnrpc.ListChannelsRequest{}
-	channelsResp, err := zlb.lndClient.ListChannels(ctx, channelsReq)
+	lndChannels, err := zlb.lnd.Client.ListChannels(ctx, false, false)
 	if err != nil {
 		return nil, err
 	}
 
 	var channels []Channel
-	for _, ch := range channelsResp.Channels {
+	for _, ch := range lndChannels {
 		channel := Channel{
-			ChannelID:     fmt.Sprintf("%d", ch.ChanId),
-			RemoteNodeID:  ch.RemotePubkey,
-			Capacity:      uint64(ch.Capacity),
-			LocalBalance:  uint64(ch.LocalBalance),
-			RemoteBalance: uint64(ch.RemoteBalance),
-			Active:        ch.Active,
+			ChannelID:         fmt.Sprintf("%d", ch.ChannelID),
+			RemoteNodeID:      ch.PubKeyBytes.String(),
+			Capacity:          uint64(ch.Capacity),
+			CapacityMsat:      uint64(amount.Sat(ch.Capacity).ToMsat()),
+			LocalBalance:      uint64(ch.LocalBalance),
+			LocalBalanceMsat:  uint64(amount.Sat(ch.LocalBalance).ToMsat()),
+			RemoteBalance:     uint64(ch.RemoteBalance),
+			RemoteBalanceMsat: uint64(amount.Sat(ch.RemoteBalance).ToMsat()),
+			Active:            ch.Active,
 		}
 		channels = append(channels, channel)
 	}
@@ -236,70 +352,68 @@ func (zlb *ZionLightningBridge) GetChannels(ctx context.Context) ([]Channel, err
 	return channels, nil
 }
 
-// CreateInvoice creates a Lightning Network invoice
-func (zlb *ZionLightningBridge) CreateInvoice(ctx context.Context, amount uint64, memo string) (*LightningPayment, error) {
-	invoiceReq := &lnrpc.Invoice{
-		Value: int64(amount),
-		Memo:  memo,
+// CreateInvoice creates a Lightning Network invoice. req.AmountMsat, if set,
+// is used at full millisatoshi precision; otherwise req.Amount (satoshis)
+// is converted losslessly. If req.ZionAddress is set, the invoice is opened
+// as a hold invoice via the swap engine's InitiateLNToZion instead of a
+// plain invoice, so the ZION credit and the Lightning settlement happen
+// atomically (credit first, then settle the HTLC, or cancel it and refund
+// the Lightning payer if the credit fails) rather than the ZION side being
+// fire-and-forget once the invoice settles. req.CallbackURL works the same
+// way either way: the invoice tracker dispatches it on settlement.
+func (zlb *ZionLightningBridge) CreateInvoice(ctx context.Context, req InvoiceRequest) (*LightningPayment, error) {
+	amtMsat := amount.Msat(req.AmountMsat)
+	if amtMsat == 0 {
+		amtMsat = amount.Sat(req.Amount).ToMsat()
+	}
+
+	if req.ZionAddress != "" {
+		amtSat, err := amtMsat.ToSat()
+		if err != nil {
+			return nil, fmt.Errorf("invoice amount crediting a ZION address must be a whole number of satoshis: %v", err)
+		}
+
+		swap, err := zlb.swaps.InitiateLNToZion(ctx, uint64(amtSat), req.ZionAddress, req.Memo)
+		if err != nil {
+			return nil, err
+		}
+
+		payment := &LightningPayment{
+			Invoice:     swap.Invoice,
+			Amount:      swap.AmountSat,
+			AmountMsat:  uint64(amount.Sat(swap.AmountSat).ToMsat()),
+			Status:      "pending",
+			Timestamp:   time.Now().Unix(),
+			PaymentHash: swap.PaymentHash,
+			ZionAddress: req.ZionAddress,
+			CallbackURL: req.CallbackURL,
+		}
+		zlb.invoices.Register(payment)
+
+		return payment, nil
 	}
 
-	invoice, err := zlb.lndClient.AddInvoice(ctx, invoiceReq)
+	hash, paymentRequest, err := zlb.lnd.Client.AddInvoice(ctx, &invoicesrpc.AddInvoiceData{
+		Value: lnwire.MilliSatoshi(amtMsat),
+		Memo:  req.Memo,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	payment := &LightningPayment{
-		Invoice:     invoice.PaymentRequest,
-		Amount:      amount,
+		Invoice:     paymentRequest,
+		Amount:      uint64(amtMsat / 1000),
+		AmountMsat:  uint64(amtMsat),
 		Status:      "pending",
 		Timestamp:   time.Now().Unix(),
-		PaymentHash: hex.EncodeToString(invoice.RHash),
-	}
-
-	return payment, nil
-}
-
-// PayInvoice pays a Lightning Network invoice
-func (zlb *ZionLightningBridge) PayInvoice(ctx context.Context, invoice, zionAddress string) error {
-	// Decode invoice to get amount
-	decodeReq := &lnrpc.PayReqString{PayReq: invoice}
-	payReq, err := zlb.lndClient.DecodePayReq(ctx, decodeReq)
-	if err != nil {
-		return fmt.Errorf("cannot decode invoice: %v", err)
+		PaymentHash: hash.String(),
+		CallbackURL: req.CallbackURL,
 	}
 
-	// Check ZION balance
-	balance, err := zlb.zionRPC.GetBalance(zionAddress)
-	if err != nil {
-		return fmt.Errorf("cannot get ZION balance: %v", err)
-	}
-
-	if balance < uint64(payReq.NumSatoshis) {
-		return fmt.Errorf("insufficient ZION balance: %d < %d", balance, payReq.NumSatoshis)
-	}
+	zlb.invoices.Register(payment)
 
-	// Send Lightning payment
-	sendReq := &lnrpc.SendRequest{
-		PaymentRequest: invoice,
-	}
-
-	payment, err := zlb.lndClient.SendPaymentSync(ctx, sendReq)
-	if err != nil {
-		return fmt.Errorf("lightning payment failed: %v", err)
-	}
-
-	if payment.PaymentError != "" {
-		return fmt.Errorf("payment error: %s", payment.PaymentError)
-	}
-
-	// Deduct from ZION balance
-	err = zlb.zionRPC.SendTransaction(zionAddress, "lightning_pool_address", uint64(payReq.NumSatoshis))
-	if err != nil {
-		log.Printf("Warning: Lightning payment succeeded but ZION deduction failed: %v", err)
-	}
-
-	log.Printf("⚡ Lightning payment successful: %s", hex.EncodeToString(payment.PaymentHash))
-	return nil
+	return payment, nil
 }
 
 // HTTP Handlers
@@ -313,88 +427,81 @@ func (zlb *ZionLightningBridge) handleHealth(c *gin.Context) {
 	})
 }
 
-func (zlb *ZionLightningBridge) handleGetNodeInfo(c *gin.Context) {
-	ctx := context.Background()
-	nodeInfo, err := zlb.GetNodeInfo(ctx)
+// handleGetRate reports the ZION-per-satoshi conversion rate and swap spread
+// currently applied to sat<->ZION accounting. The rate is reported as an
+// exact numerator/denominator pair, not a decimal, so a client can reproduce
+// the same conversion the bridge itself computes via pkg/amount.SatToZion
+// instead of re-deriving it from a rounded value.
+func (zlb *ZionLightningBridge) handleGetRate(c *gin.Context) {
+	rate, err := zlb.rateProvider.ZionPerSat(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
-	c.JSON(http.StatusOK, nodeInfo)
+	c.JSON(http.StatusOK, gin.H{
+		"zion_per_sat_numerator":   rate.Num().String(),
+		"zion_per_sat_denominator": rate.Denom().String(),
+		"spread_bps":               zlb.config.SwapSpreadBps,
+	})
 }
 
-func (zlb *ZionLightningBridge) handleGetChannels(c *gin.Context) {
-	ctx := context.Background()
-	channels, err := zlb.GetChannels(ctx)
+// newGatewayMux builds the grpc-gateway reverse proxy that backs the
+// /api/v1/* routes generated from zionbridge.proto, dialing the bridge's own
+// gRPC listener over loopback TLS. Marshaling is configured with
+// UseProtoNames so the JSON shape matches the snake_case fields the old
+// hand-written Gin handlers produced.
+func newGatewayMux(ctx context.Context, grpcAddr string, tlsCert tls.Certificate) (*runtime.ServeMux, error) {
+	pool := x509.NewCertPool()
+	leaf, err := x509.ParseCertificate(tlsCert.Certificate[0])
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+		return nil, fmt.Errorf("cannot parse TLS certificate: %v", err)
 	}
-	c.JSON(http.StatusOK, gin.H{"channels": channels})
-}
+	pool.AddCert(leaf)
 
-func (zlb *ZionLightningBridge) handleCreateInvoice(c *gin.Context) {
-	var req InvoiceRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:    pool,
+			ServerName: "localhost",
+		})),
 	}
 
-	ctx := context.Background()
-	payment, err := zlb.CreateInvoice(ctx, req.Amount, req.Memo)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+	gwmux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}))
+	if err := zionbridgerpc.RegisterZionBridgeHandlerFromEndpoint(ctx, gwmux, grpcAddr, dialOpts); err != nil {
+		return nil, fmt.Errorf("cannot register grpc-gateway handler: %v", err)
 	}
-
-	c.JSON(http.StatusOK, payment)
+	return gwmux, nil
 }
 
-func (zlb *ZionLightningBridge) handlePayInvoice(c *gin.Context) {
-	var req PaymentRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	ctx := context.Background()
-	err := zlb.PayInvoice(ctx, req.Invoice, req.ZionAddress)
+// startGRPCServer starts the bridge's own gRPC listener on config.GRPCPort,
+// serving the ZionBridge service defined in zionbridge.proto over TLS.
+// Every RPC is authenticated against a bakery-v2 macaroon by
+// unaryMacaroonInterceptor/streamMacaroonInterceptor before it reaches the
+// handler, using the permission map in bridgeRPCPermissions.
+func startGRPCServer(config *Config, bridge *ZionLightningBridge, tlsCert tls.Certificate, macaroonSvc *macaroons.Service) (*grpc.Server, error) {
+	lis, err := net.Listen("tcp", ":"+config.GRPCPort)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+		return nil, fmt.Errorf("cannot listen for gRPC: %v", err)
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":  "success",
-		"message": "Lightning payment completed",
-		"mantra":  "⚡ Jai Ram Ram Ram Sita Ram Ram Ram Hanuman! ⚡",
-	})
-}
-
-// MacaroonCredential wraps a macaroon to implement credentials.PerRPCCredentials
-type MacaroonCredential struct {
-	*macaroon.Macaroon
-}
-
-// NewMacaroonCredential creates a new macaroon credential
-func NewMacaroonCredential(mac *macaroon.Macaroon) *MacaroonCredential {
-	return &MacaroonCredential{mac}
-}
+	creds := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{tlsCert}})
+	grpcServer := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(unaryMacaroonInterceptor(macaroonSvc)),
+		grpc.ChainStreamInterceptor(streamMacaroonInterceptor(macaroonSvc)),
+	)
+	zionbridgerpc.RegisterZionBridgeServer(grpcServer, newGRPCServer(bridge))
 
-// RequireTransportSecurity implements credentials.PerRPCCredentials
-func (mc *MacaroonCredential) RequireTransportSecurity() bool {
-	return true
-}
+	go func() {
+		log.Printf("gRPC server listening on port %s", config.GRPCPort)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Printf("Warning: gRPC server stopped: %v", err)
+		}
+	}()
 
-// GetRequestMetadata implements credentials.PerRPCCredentials
-func (mc *MacaroonCredential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
-	macBytes, err := mc.MarshalBinary()
-	if err != nil {
-		return nil, err
-	}
-	return map[string]string{
-		"macaroon": hex.EncodeToString(macBytes),
-	}, nil
+	return grpcServer, nil
 }
 
 func main() {
@@ -407,6 +514,41 @@ func main() {
 		log.Fatalf("Failed to create bridge: %v", err)
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := bridge.invoices.Start(ctx); err != nil {
+		log.Fatalf("Failed to start invoice tracker: %v", err)
+	}
+	bridge.swaps.Start(ctx)
+
+	// Start the typed gRPC surface defined in zionbridge.proto, then the
+	// grpc-gateway REST/JSON reverse proxy generated from it, which is what
+	// actually answers /api/v1/* now instead of hand-written Gin handlers.
+	tlsCert, err := loadOrCreateTLSCertificate(config.GRPCTLSCertPath, config.GRPCTLSKeyPath)
+	if err != nil {
+		log.Fatalf("Failed to load bridge TLS certificate: %v", err)
+	}
+
+	// Bake (or load) the bridge's own admin/invoice/readonly macaroons and
+	// wire their enforcement into both the gRPC and REST surfaces, so the
+	// API is no longer reachable by anyone who can merely route to the
+	// port.
+	macaroonSvc, err := openMacaroonService(config.MacaroonDBPath)
+	if err != nil {
+		log.Fatalf("Failed to open macaroon service: %v", err)
+	}
+	if _, err := bakeMacaroonFiles(ctx, macaroonSvc, config.MacaroonDir, config.PayInvoiceMaxMsat); err != nil {
+		log.Fatalf("Failed to bake macaroons: %v", err)
+	}
+	bridge.macaroonSvc = macaroonSvc
+	if _, err := startGRPCServer(config, bridge, tlsCert, macaroonSvc); err != nil {
+		log.Fatalf("Failed to start gRPC server: %v", err)
+	}
+	gwmux, err := newGatewayMux(ctx, "localhost:"+config.GRPCPort, tlsCert)
+	if err != nil {
+		log.Fatalf("Failed to start grpc-gateway: %v", err)
+	}
+
 	// Setup Gin router
 	gin.SetMode(gin.ReleaseMode)
 	r := gin.Default()
@@ -416,23 +558,56 @@ func main() {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
-		
+
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
 			return
 		}
-		
+
 		c.Next()
 	})
 
-	// Routes
+	// Add LSAT (HTTP 402) paywall middleware. Routes with no entry in the
+	// price table pass straight through.
+	lsatRootKey, err := loadOrCreateLSATRootKey(config.LSATRootKeyPath)
+	if err != nil {
+		log.Fatalf("Failed to load LSAT root key: %v", err)
+	}
+	lsatMiddleware := lsat.NewMiddleware(lsatRootKey, &lsatInvoicer{zlb: bridge}, lsatPriceTable(), time.Hour, 1000, 4096)
+	r.Use(lsatMiddleware.Handler())
+
+	// Add macaroon auth. This is a separate access-control dimension from
+	// the LSAT middleware above: LSAT gates specific metered routes behind
+	// payment, macaroons gate the whole API behind possession of a token
+	// scoped to the right entity:action permissions.
+	r.Use(macaroonMiddleware(macaroonSvc))
+
+	// Routes. Every /api/v1/* route below except /health, /rate and
+	// /premium/* is now served by the grpc-gateway mux generated from
+	// zionbridge.proto; they're still registered one at a time (rather than
+	// as a single wildcard) so Gin's FullPath-keyed LSAT price table keeps
+	// working.
 	api := r.Group("/api/v1")
 	{
 		api.GET("/health", bridge.handleHealth)
-		api.GET("/node/info", bridge.handleGetNodeInfo)
-		api.GET("/channels", bridge.handleGetChannels)
-		api.POST("/invoice", bridge.handleCreateInvoice)
-		api.POST("/pay", bridge.handlePayInvoice)
+		api.GET("/rate", bridge.handleGetRate)
+		api.GET("/node/info", gin.WrapH(gwmux))
+		api.GET("/channels", gin.WrapH(gwmux))
+		api.POST("/invoice", gin.WrapH(gwmux))
+		api.GET("/invoice/:hash", gin.WrapH(gwmux))
+		api.GET("/invoice/:hash/wait", gin.WrapH(gwmux))
+		api.GET("/invoices/subscribe", gin.WrapH(gwmux))
+		api.POST("/pay", gin.WrapH(gwmux))
+		api.GET("/swaps", gin.WrapH(gwmux))
+		api.GET("/swaps/:id", gin.WrapH(gwmux))
+		api.GET("/swaps/subscribe", gin.WrapH(gwmux))
+		api.POST("/channels/open", gin.WrapH(gwmux))
+		api.POST("/channels/close", gin.WrapH(gwmux))
+
+		premium := api.Group("/premium")
+		{
+			premium.GET("/node-report", bridge.handlePremiumNodeReport)
+		}
 	}
 
 	// Legacy routes for compatibility
@@ -441,8 +616,8 @@ func main() {
 	log.Printf("🌩️ ZION Lightning Bridge starting on port %s", config.BridgePort)
 	log.Printf("⚡ Jai Ram Ram Ram Sita Ram Ram Ram Hanuman! ⚡")
 	log.Printf("🚀 Lightning Network integration ready!")
-	
+
 	if err := r.Run(":" + config.BridgePort); err != nil {
 		log.Fatalf("Failed to start server: %v", err)
 	}
-}
\ No newline at end of file
+}
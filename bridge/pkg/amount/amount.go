@@ -0,0 +1,113 @@
+// Package amount gives the bridge explicit, truncation-safe types for the
+// three units of value it moves between -- Lightning millisatoshis,
+// satoshis, and ZION's own atomic unit -- plus the exchange-rate policy
+// used to convert between the Lightning and ZION sides of a swap, instead
+// of treating a sat amount as a 1:1 ZION amount.
+package amount
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// Msat is an amount in millisatoshis, the precision Lightning HTLCs and
+// invoices carry internally (mirroring lnwire.MilliSatoshi).
+type Msat uint64
+
+// Sat is an amount in whole satoshis.
+type Sat uint64
+
+// ZionAtomic is an amount in ZION's smallest on-chain unit.
+type ZionAtomic uint64
+
+const msatPerSat = 1000
+
+// ToSat converts m to whole satoshis. It returns an error instead of
+// truncating if m doesn't land on a satoshi boundary, since silently
+// dropping sub-satoshi value is exactly the bug this package exists to
+// rule out.
+func (m Msat) ToSat() (Sat, error) {
+	if m%msatPerSat != 0 {
+		return 0, fmt.Errorf("%d msat does not divide evenly into satoshis", m)
+	}
+	return Sat(m / msatPerSat), nil
+}
+
+// ToMsat converts s to millisatoshis. This never loses precision: a
+// satoshi is always an exact multiple of a millisatoshi.
+func (s Sat) ToMsat() Msat {
+	return Msat(s) * msatPerSat
+}
+
+// RateProvider supplies the ZION-per-satoshi exchange rate the bridge
+// applies when converting between the Lightning and ZION sides of a swap.
+// It's deliberately an interface so FixedRateProvider can later be swapped
+// for a live price oracle without touching any call site.
+type RateProvider interface {
+	// ZionPerSat returns how many ZION atomic units one satoshi is worth.
+	ZionPerSat(ctx context.Context) (*big.Rat, error)
+}
+
+// FixedRateProvider is a RateProvider that always returns the same
+// configured ratio. It's the bridge's default until a real price oracle is
+// wired in behind the same interface.
+type FixedRateProvider struct {
+	rate *big.Rat
+}
+
+// NewFixedRateProvider parses ratio (e.g. "1000000" or "3/2") as a
+// ZION-per-satoshi rate.
+func NewFixedRateProvider(ratio string) (*FixedRateProvider, error) {
+	rate, ok := new(big.Rat).SetString(ratio)
+	if !ok {
+		return nil, fmt.Errorf("invalid ZION-per-satoshi rate %q", ratio)
+	}
+	if rate.Sign() <= 0 {
+		return nil, fmt.Errorf("ZION-per-satoshi rate must be positive, got %q", ratio)
+	}
+	return &FixedRateProvider{rate: rate}, nil
+}
+
+// ZionPerSat implements RateProvider.
+func (p *FixedRateProvider) ZionPerSat(_ context.Context) (*big.Rat, error) {
+	return p.rate, nil
+}
+
+// Conversion is the result of applying a RateProvider's rate (and the
+// bridge's spread) to a satoshi amount.
+type Conversion struct {
+	RateZionPerSat *big.Rat
+	SpreadBps      uint64
+	Zion           ZionAtomic
+}
+
+// SatToZion converts sat to ZION at rate, taking an additional spreadBps
+// (basis points, i.e. 1/100th of a percent) as the bridge's fee. The fee is
+// subtracted from the converted amount, so a caller never receives more
+// ZION than the raw conversion (with a spreadBps of 0, the raw conversion
+// itself). The result is
+// floored to the nearest whole ZionAtomic unit; it is never rounded up,
+// for the same reason.
+func SatToZion(sat Sat, rate *big.Rat, spreadBps uint64) (*Conversion, error) {
+	if rate == nil || rate.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid conversion rate")
+	}
+	if spreadBps >= 10000 {
+		return nil, fmt.Errorf("spread of %d bps would consume the entire payment", spreadBps)
+	}
+
+	gross := new(big.Rat).Mul(new(big.Rat).SetUint64(uint64(sat)), rate)
+	net := new(big.Rat).Mul(gross, big.NewRat(int64(10000-spreadBps), 10000))
+
+	q := new(big.Int).Quo(net.Num(), net.Denom())
+	if !q.IsUint64() {
+		return nil, fmt.Errorf("converted amount overflows uint64")
+	}
+
+	return &Conversion{
+		RateZionPerSat: rate,
+		SpreadBps:      spreadBps,
+		Zion:           ZionAtomic(q.Uint64()),
+	}, nil
+}
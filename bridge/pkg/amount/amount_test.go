@@ -0,0 +1,106 @@
+package amount
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestMsatToSat(t *testing.T) {
+	tests := []struct {
+		name    string
+		msat    Msat
+		want    Sat
+		wantErr bool
+	}{
+		{name: "exact", msat: 5000, want: 5},
+		{name: "zero", msat: 0, want: 0},
+		{name: "sub-satoshi remainder", msat: 5001, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.msat.ToSat()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ToSat(%d) = %d, want error", tt.msat, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToSat(%d) returned unexpected error: %v", tt.msat, err)
+			}
+			if got != tt.want {
+				t.Fatalf("ToSat(%d) = %d, want %d", tt.msat, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSatToMsat(t *testing.T) {
+	if got, want := Sat(7).ToMsat(), Msat(7000); got != want {
+		t.Fatalf("Sat(7).ToMsat() = %d, want %d", got, want)
+	}
+}
+
+func TestSatToZion(t *testing.T) {
+	rate := big.NewRat(1000000, 1) // 1,000,000 ZION atomic units per sat
+
+	t.Run("no spread", func(t *testing.T) {
+		conv, err := SatToZion(100, rate, 0)
+		if err != nil {
+			t.Fatalf("SatToZion returned unexpected error: %v", err)
+		}
+		if conv.Zion != 100_000_000 {
+			t.Fatalf("Zion = %d, want %d", conv.Zion, 100_000_000)
+		}
+	})
+
+	t.Run("spread is subtracted", func(t *testing.T) {
+		conv, err := SatToZion(100, rate, 100) // 1% spread
+		if err != nil {
+			t.Fatalf("SatToZion returned unexpected error: %v", err)
+		}
+		want := ZionAtomic(99_000_000) // 100,000,000 - 1%
+		if conv.Zion != want {
+			t.Fatalf("Zion = %d, want %d", conv.Zion, want)
+		}
+	})
+
+	t.Run("result floors rather than rounds", func(t *testing.T) {
+		// 1 sat at a 1/3 rate nets 0.333... ZionAtomic units, which must
+		// floor to 0 rather than round up to 1.
+		conv, err := SatToZion(1, big.NewRat(1, 3), 0)
+		if err != nil {
+			t.Fatalf("SatToZion returned unexpected error: %v", err)
+		}
+		if conv.Zion != 0 {
+			t.Fatalf("Zion = %d, want 0", conv.Zion)
+		}
+	})
+
+	t.Run("spread of 100% is rejected", func(t *testing.T) {
+		if _, err := SatToZion(100, rate, 10000); err == nil {
+			t.Fatal("SatToZion with a 10000bps spread should error, got nil")
+		}
+	})
+
+	t.Run("nil rate is rejected", func(t *testing.T) {
+		if _, err := SatToZion(100, nil, 0); err == nil {
+			t.Fatal("SatToZion with a nil rate should error, got nil")
+		}
+	})
+
+	t.Run("non-positive rate is rejected", func(t *testing.T) {
+		if _, err := SatToZion(100, big.NewRat(0, 1), 0); err == nil {
+			t.Fatal("SatToZion with a zero rate should error, got nil")
+		}
+	})
+
+	t.Run("overflow is rejected", func(t *testing.T) {
+		huge := new(big.Rat).SetUint64(math.MaxUint64)
+		if _, err := SatToZion(math.MaxUint64, huge, 0); err == nil {
+			t.Fatal("SatToZion overflowing uint64 should error, got nil")
+		}
+	})
+}
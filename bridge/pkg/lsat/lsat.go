@@ -0,0 +1,293 @@
+// Package lsat implements Lightning Service Authentication Tokens (LSATs)
+// as a Gin middleware: an unauthenticated request to a protected route is
+// challenged with a 402 carrying a macaroon and an invoice, and a request
+// bearing a paid macaroon+preimage pair is let through.
+package lsat
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const (
+	headerAuthorization   = "Authorization"
+	headerWWWAuthenticate = "WWW-Authenticate"
+	lsatScheme            = "LSAT"
+
+	caveatPaymentHash = "payment_hash"
+	caveatExpiresAt   = "expires_at"
+	caveatRoute       = "route"
+)
+
+// Invoicer is the subset of bridge functionality the LSAT middleware needs
+// to mint and check the Lightning invoice backing a macaroon.
+type Invoicer interface {
+	// CreateInvoice creates an invoice for amountSat and returns its
+	// encoded payment request and payment hash (hex-encoded).
+	CreateInvoice(ctx context.Context, amountSat uint64, memo string) (paymentRequest, paymentHash string, err error)
+
+	// IsSettled reports whether the invoice identified by paymentHash
+	// (hex-encoded) has been settled.
+	IsSettled(ctx context.Context, paymentHash string) (bool, error)
+}
+
+// PriceTable maps "METHOD /path" route keys to the price, in satoshis, of
+// accessing that route.
+type PriceTable map[string]uint64
+
+// RouteKey builds the PriceTable key for a request.
+func RouteKey(method, path string) string {
+	return method + " " + path
+}
+
+// Middleware bakes and verifies LSAT macaroons for a Gin router.
+type Middleware struct {
+	rootKey       []byte
+	invoicer      Invoicer
+	prices        PriceTable
+	ttl           time.Duration
+	quotaPerToken int
+	used          *replayCache
+}
+
+// NewMiddleware creates an LSAT middleware. rootKey signs every macaroon
+// this bridge bakes; prices gives the per-route price in satoshis. ttl is
+// how long a freshly minted macaroon remains valid before its expires_at
+// caveat rejects it, and for that whole window the same paid
+// macaroon+preimage is a reusable bearer credential rather than a single-use
+// token. quotaPerToken caps how many requests one preimage can make within
+// that window while its count is tracked; tokenCacheSize bounds how many
+// distinct preimages' quotas are tracked at once, and a preimage evicted from
+// that cache starts over with a fresh quota.
+func NewMiddleware(rootKey []byte, invoicer Invoicer, prices PriceTable, ttl time.Duration, quotaPerToken, tokenCacheSize int) *Middleware {
+	return &Middleware{
+		rootKey:       rootKey,
+		invoicer:      invoicer,
+		prices:        prices,
+		ttl:           ttl,
+		quotaPerToken: quotaPerToken,
+		used:          newReplayCache(tokenCacheSize),
+	}
+}
+
+// Handler returns the Gin middleware. Routes with no entry in the price
+// table are left unprotected.
+func (m *Middleware) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		priceSat, protected := m.prices[RouteKey(c.Request.Method, c.FullPath())]
+		if !protected {
+			c.Next()
+			return
+		}
+
+		authHeader := c.GetHeader(headerAuthorization)
+		if authHeader == "" {
+			m.challenge(c, priceSat)
+			return
+		}
+
+		if err := m.verify(c.Request.Context(), authHeader, c.Request.Method, c.FullPath()); err != nil {
+			m.challenge(c, priceSat)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// challenge mints a fresh macaroon + invoice pair and responds 402.
+func (m *Middleware) challenge(c *gin.Context, priceSat uint64) {
+	ctx := c.Request.Context()
+	memo := fmt.Sprintf("LSAT access to %s", c.FullPath())
+
+	paymentRequest, paymentHash, err := m.invoicer.CreateInvoice(ctx, priceSat, memo)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"error": fmt.Sprintf("cannot mint invoice: %v", err)})
+		return
+	}
+
+	mac, err := macaroon.New(m.rootKey, []byte(paymentHash), "zion-lightning-bridge", macaroon.V2)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"error": fmt.Sprintf("cannot bake macaroon: %v", err)})
+		return
+	}
+
+	expiresAt := time.Now().Add(m.ttl).Unix()
+	caveats := []string{
+		caveatPaymentHash + "=" + paymentHash,
+		caveatRoute + "=" + RouteKey(c.Request.Method, c.FullPath()),
+		caveatExpiresAt + "=" + strconv.FormatInt(expiresAt, 10),
+	}
+	for _, cav := range caveats {
+		if err := mac.AddFirstPartyCaveat([]byte(cav)); err != nil {
+			c.AbortWithStatusJSON(500, gin.H{"error": fmt.Sprintf("cannot add caveat: %v", err)})
+			return
+		}
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"error": fmt.Sprintf("cannot marshal macaroon: %v", err)})
+		return
+	}
+	macB64 := base64.StdEncoding.EncodeToString(macBytes)
+
+	c.Header(headerWWWAuthenticate, fmt.Sprintf(
+		"%s macaroon=%q, invoice=%q", lsatScheme, macB64, paymentRequest))
+	c.AbortWithStatusJSON(402, gin.H{
+		"error":    "payment required",
+		"macaroon": macB64,
+		"invoice":  paymentRequest,
+	})
+}
+
+// verify checks a bearer token of the form "LSAT <macaroon_b64>:<preimage_hex>".
+func (m *Middleware) verify(ctx context.Context, authHeader, method, path string) error {
+	scheme, token, ok := strings.Cut(authHeader, " ")
+	if !ok || !strings.EqualFold(scheme, lsatScheme) {
+		return fmt.Errorf("unsupported authorization scheme")
+	}
+
+	macB64, preimageHex, ok := strings.Cut(token, ":")
+	if !ok {
+		return fmt.Errorf("malformed LSAT token")
+	}
+
+	macBytes, err := base64.StdEncoding.DecodeString(macB64)
+	if err != nil {
+		return fmt.Errorf("cannot decode macaroon: %v", err)
+	}
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return fmt.Errorf("cannot unmarshal macaroon: %v", err)
+	}
+
+	preimage, err := hex.DecodeString(preimageHex)
+	if err != nil {
+		return fmt.Errorf("cannot decode preimage: %v", err)
+	}
+
+	var paymentHash, routeScope string
+	var expiresAt int64
+	check := func(caveat string) error {
+		cond, arg, ok := strings.Cut(caveat, "=")
+		if !ok {
+			return fmt.Errorf("malformed caveat %q", caveat)
+		}
+		switch cond {
+		case caveatPaymentHash:
+			paymentHash = arg
+		case caveatRoute:
+			routeScope = arg
+		case caveatExpiresAt:
+			ts, err := strconv.ParseInt(arg, 10, 64)
+			if err != nil {
+				return fmt.Errorf("malformed expires_at caveat: %v", err)
+			}
+			expiresAt = ts
+		default:
+			return fmt.Errorf("unrecognized caveat %q", cond)
+		}
+		return nil
+	}
+
+	if err := mac.Verify(m.rootKey, check, nil); err != nil {
+		return fmt.Errorf("macaroon verification failed: %v", err)
+	}
+
+	if routeScope != RouteKey(method, path) {
+		return fmt.Errorf("macaroon is not scoped to this route")
+	}
+	if expiresAt != 0 && time.Now().Unix() > expiresAt {
+		return fmt.Errorf("macaroon expired")
+	}
+
+	sum := sha256.Sum256(preimage)
+	wantHash, err := hex.DecodeString(paymentHash)
+	if err != nil || len(wantHash) != len(sum) {
+		return fmt.Errorf("malformed payment_hash caveat")
+	}
+	if subtle.ConstantTimeCompare(sum[:], wantHash) != 1 {
+		return fmt.Errorf("preimage does not match payment_hash caveat")
+	}
+
+	settled, err := m.invoicer.IsSettled(ctx, paymentHash)
+	if err != nil {
+		return fmt.Errorf("cannot check invoice settlement: %v", err)
+	}
+	if !settled {
+		return fmt.Errorf("invoice not settled")
+	}
+
+	if !m.used.Allow(preimageHex, m.quotaPerToken) {
+		return fmt.Errorf("token has exceeded its request quota")
+	}
+	return nil
+}
+
+// replayCache tracks, for a small bounded LRU of recently-seen preimages,
+// how many requests each has made. A paid LSAT is a reusable bearer
+// credential for the lifetime of its expires_at caveat, so unlike a
+// single-use token, repeated use is expected; this only caps how many times
+// one can be used while its entry stays in the cache (see Allow for what
+// eviction does to the count).
+type replayCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type replayEntry struct {
+	key   string
+	count int
+}
+
+func newReplayCache(capacity int) *replayCache {
+	if capacity <= 0 {
+		capacity = 1024
+	}
+	return &replayCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// Allow records a use of key and reports whether it's still within quota
+// (quota <= 0 means unlimited). The LRU eviction only bounds memory use, so
+// an evicted token's count resets rather than denying it outright.
+func (c *replayCache) Allow(key string, quota int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		entry := el.Value.(*replayEntry)
+		entry.count++
+		return quota <= 0 || entry.count <= quota
+	}
+
+	c.items[key] = c.ll.PushFront(&replayEntry{key: key, count: 1})
+	for c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest == nil {
+			break
+		}
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*replayEntry).key)
+	}
+	return quota <= 0 || quota >= 1
+}
@@ -0,0 +1,156 @@
+package lsat
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// fakeInvoicer is an Invoicer test double whose settlement state is set
+// directly, without needing a real Lightning node.
+type fakeInvoicer struct {
+	settled map[string]bool
+}
+
+func (f *fakeInvoicer) CreateInvoice(_ context.Context, _ uint64, _ string) (string, string, error) {
+	return "", "", fmt.Errorf("not implemented")
+}
+
+func (f *fakeInvoicer) IsSettled(_ context.Context, paymentHash string) (bool, error) {
+	return f.settled[paymentHash], nil
+}
+
+const testRootKey = "test-root-key"
+const testMethod = "POST"
+const testPath = "/api/v1/pay"
+
+// mintToken bakes a macaroon scoped the same way challenge() does, and
+// returns the "LSAT <macaroon_b64>:<preimage_hex>" bearer token plus the
+// preimage's payment hash, so the caller can mark it settled.
+func mintToken(t *testing.T, ttl time.Duration, route string) (token, paymentHash string) {
+	t.Helper()
+
+	var preimage [32]byte
+	if _, err := rand.Read(preimage[:]); err != nil {
+		t.Fatalf("cannot generate preimage: %v", err)
+	}
+	sum := sha256.Sum256(preimage[:])
+	paymentHash = hex.EncodeToString(sum[:])
+
+	mac, err := macaroon.New([]byte(testRootKey), []byte(paymentHash), "zion-lightning-bridge", macaroon.V2)
+	if err != nil {
+		t.Fatalf("cannot bake macaroon: %v", err)
+	}
+	caveats := []string{
+		caveatPaymentHash + "=" + paymentHash,
+		caveatRoute + "=" + route,
+	}
+	if ttl != 0 {
+		caveats = append(caveats, caveatExpiresAt+"="+strconv.FormatInt(time.Now().Add(ttl).Unix(), 10))
+	}
+	for _, cav := range caveats {
+		if err := mac.AddFirstPartyCaveat([]byte(cav)); err != nil {
+			t.Fatalf("cannot add caveat %q: %v", cav, err)
+		}
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("cannot marshal macaroon: %v", err)
+	}
+	macB64 := base64.StdEncoding.EncodeToString(macBytes)
+
+	return lsatScheme + " " + macB64 + ":" + hex.EncodeToString(preimage[:]), paymentHash
+}
+
+func newTestMiddleware(settled map[string]bool, quotaPerToken int) *Middleware {
+	return NewMiddleware([]byte(testRootKey), &fakeInvoicer{settled: settled}, PriceTable{}, time.Hour, quotaPerToken, 16)
+}
+
+func TestMiddlewareVerifyAcceptsSettledToken(t *testing.T) {
+	token, hash := mintToken(t, time.Hour, RouteKey(testMethod, testPath))
+	m := newTestMiddleware(map[string]bool{hash: true}, 4)
+
+	if err := m.verify(context.Background(), token, testMethod, testPath); err != nil {
+		t.Fatalf("verify() returned unexpected error: %v", err)
+	}
+}
+
+func TestMiddlewareVerifyRejectsUnsettledInvoice(t *testing.T) {
+	token, hash := mintToken(t, time.Hour, RouteKey(testMethod, testPath))
+	m := newTestMiddleware(map[string]bool{hash: false}, 4)
+
+	if err := m.verify(context.Background(), token, testMethod, testPath); err == nil {
+		t.Fatal("verify() should reject a token whose invoice isn't settled")
+	}
+}
+
+func TestMiddlewareVerifyRejectsWrongRoute(t *testing.T) {
+	token, hash := mintToken(t, time.Hour, RouteKey(testMethod, testPath))
+	m := newTestMiddleware(map[string]bool{hash: true}, 4)
+
+	if err := m.verify(context.Background(), token, "GET", "/api/v1/channels"); err == nil {
+		t.Fatal("verify() should reject a token scoped to a different route")
+	}
+}
+
+func TestMiddlewareVerifyRejectsExpiredToken(t *testing.T) {
+	token, hash := mintToken(t, -time.Hour, RouteKey(testMethod, testPath))
+	m := newTestMiddleware(map[string]bool{hash: true}, 4)
+
+	if err := m.verify(context.Background(), token, testMethod, testPath); err == nil {
+		t.Fatal("verify() should reject an expired token")
+	}
+}
+
+func TestMiddlewareVerifyAllowsReuseWithinQuota(t *testing.T) {
+	token, hash := mintToken(t, time.Hour, RouteKey(testMethod, testPath))
+	m := newTestMiddleware(map[string]bool{hash: true}, 2)
+
+	for i := 0; i < 2; i++ {
+		if err := m.verify(context.Background(), token, testMethod, testPath); err != nil {
+			t.Fatalf("verify() call %d returned unexpected error: %v", i+1, err)
+		}
+	}
+	if err := m.verify(context.Background(), token, testMethod, testPath); err == nil {
+		t.Fatal("verify() should reject a token once its quota is exhausted")
+	}
+}
+
+func TestReplayCacheAllow(t *testing.T) {
+	c := newReplayCache(16)
+
+	if !c.Allow("a", 2) || !c.Allow("a", 2) {
+		t.Fatal("Allow should permit uses within quota")
+	}
+	if c.Allow("a", 2) {
+		t.Fatal("Allow should deny a use once quota is exhausted")
+	}
+
+	if !c.Allow("b", 0) || !c.Allow("b", 0) || !c.Allow("b", 0) {
+		t.Fatal("Allow with quota<=0 should never deny")
+	}
+}
+
+func TestReplayCacheEvictsOldestOnOverflow(t *testing.T) {
+	c := newReplayCache(2)
+
+	c.Allow("a", 0)
+	c.Allow("b", 0)
+	c.Allow("c", 0) // evicts "a"
+
+	if len(c.items) != 2 {
+		t.Fatalf("cache should hold at most 2 entries, has %d", len(c.items))
+	}
+	if _, ok := c.items["a"]; ok {
+		t.Fatal("oldest entry should have been evicted")
+	}
+}
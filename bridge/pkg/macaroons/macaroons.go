@@ -0,0 +1,273 @@
+// Package macaroons bakes and validates the bakery-v2 macaroons that guard
+// the bridge's own gRPC/REST API. This is a separate access-control
+// dimension from the payment-gated LSATs in pkg/lsat: a macaroon says who
+// is allowed to call an RPC at all, while an LSAT says whether a specific
+// metered route has been paid for.
+package macaroons
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"go.etcd.io/bbolt"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+// Entity/action names used to build the bakery.Op permissions this bridge
+// grants. They mirror the resources the gRPC surface in zionbridgerpc
+// actually exposes.
+const (
+	EntityNode     = "node"
+	EntityInvoice  = "invoice"
+	EntityOffchain = "offchain"
+	EntityChannel  = "channel"
+
+	ActionRead  = "read"
+	ActionWrite = "write"
+)
+
+// Permission is a single entity:action pair an RPC requires its caller's
+// macaroon to be authorized for.
+type Permission struct {
+	Entity string
+	Action string
+}
+
+func (p Permission) op() bakery.Op {
+	return bakery.Op{Entity: p.Entity, Action: p.Action}
+}
+
+// Custom first-party caveat conditions this bridge understands, beyond the
+// bakery library's standard ones (notably time-before, which is reused
+// as-is for expiry instead of a bespoke "expires-at" checker).
+const (
+	CondIPRange        = "ip-range"
+	CondMaxPaymentMsat = "max-payment-msat"
+)
+
+var rootKeyBucketName = []byte("macaroon_root_keys")
+var defaultRootKeyID = []byte("0")
+
+const rootKeyLen = 32
+
+// BoltStore is a bakery.RootKeyStore backed by the bridge's own bbolt
+// database, keeping macaroon storage on the same embedded-KV footing the
+// swap engine already uses instead of pulling in lnd's heavier,
+// password-unlocked RootKeyStorage.
+type BoltStore struct {
+	db *bbolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) the root key bucket in db.
+func NewBoltStore(db *bbolt.DB) (*BoltStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(rootKeyBucketName)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open macaroon root key bucket: %v", err)
+	}
+	return &BoltStore{db: db}, nil
+}
+
+// Get implements bakery.RootKeyStore.
+func (s *BoltStore) Get(_ context.Context, id []byte) ([]byte, error) {
+	var key []byte
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(rootKeyBucketName).Get(id); v != nil {
+			key = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, bakery.ErrNotFound
+	}
+	return key, nil
+}
+
+// RootKey implements bakery.RootKeyStore. The bridge bakes a small, fixed
+// set of macaroons rather than one per request, so a single persisted
+// root key under defaultRootKeyID is reused for all of them.
+func (s *BoltStore) RootKey(ctx context.Context) ([]byte, []byte, error) {
+	if key, err := s.Get(ctx, defaultRootKeyID); err == nil {
+		return key, defaultRootKeyID, nil
+	}
+
+	key := make([]byte, rootKeyLen)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, fmt.Errorf("cannot generate macaroon root key: %v", err)
+	}
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(rootKeyBucketName).Put(defaultRootKeyID, key)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, defaultRootKeyID, nil
+}
+
+// Service bakes and validates the bridge's own macaroons on top of a
+// bakery.Bakery, independent of the LND-client macaroon auth (removed in
+// favour of lndclient) and the payment-gated LSAT middleware.
+type Service struct {
+	bakery *bakery.Bakery
+}
+
+// NewService creates a Service whose root key is persisted in db, and
+// registers this bridge's custom first-party caveat checkers (ip-range,
+// max-payment-msat) alongside the bakery library's standard ones
+// (time-before, used here for macaroon expiry).
+func NewService(db *bbolt.DB, location string) (*Service, error) {
+	store, err := NewBoltStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	checker := checkers.New(nil)
+	checker.Register(CondIPRange, checkers.StdNamespace, checkIPRange)
+	checker.Register(CondMaxPaymentMsat, checkers.StdNamespace, checkMaxPaymentMsat)
+
+	b := bakery.New(bakery.BakeryParams{
+		Location:     location,
+		RootKeyStore: store,
+		Checker:      checker,
+	})
+
+	return &Service{bakery: b}, nil
+}
+
+// Bake mints a new macaroon authorized for perms. expiresAt, if non-zero,
+// is enforced via the standard time-before caveat; ipRange (CIDR) and
+// maxPaymentMsat, if non-zero/non-empty, add the matching custom caveats.
+func (s *Service) Bake(ctx context.Context, expiresAt time.Time, ipRange string, maxPaymentMsat uint64, perms ...Permission) (*macaroon.Macaroon, error) {
+	ops := make([]bakery.Op, len(perms))
+	for i, p := range perms {
+		ops[i] = p.op()
+	}
+
+	var caveats []checkers.Caveat
+	if !expiresAt.IsZero() {
+		caveats = append(caveats, checkers.TimeBeforeCaveat(expiresAt))
+	}
+	if ipRange != "" {
+		if _, _, err := net.ParseCIDR(ipRange); err != nil {
+			return nil, fmt.Errorf("invalid ip-range %q: %v", ipRange, err)
+		}
+		caveats = append(caveats, checkers.Caveat{Condition: CondIPRange + " " + ipRange})
+	}
+	if maxPaymentMsat > 0 {
+		caveats = append(caveats, checkers.Caveat{
+			Condition: fmt.Sprintf("%s %d", CondMaxPaymentMsat, maxPaymentMsat),
+		})
+	}
+
+	mac, err := s.bakery.Oven.NewMacaroon(ctx, bakery.LatestVersion, caveats, ops...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot bake macaroon: %v", err)
+	}
+	return mac.M(), nil
+}
+
+// Authorize checks that the serialized macaroon in macBytes grants every
+// permission in perms, running it through any custom caveats (ip-range,
+// max-payment-msat) using the client IP / requested amount attached to ctx
+// via ContextWithClientIP / ContextWithPaymentMsat.
+func (s *Service) Authorize(ctx context.Context, macBytes []byte, perms ...Permission) error {
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return fmt.Errorf("invalid macaroon: %v", err)
+	}
+
+	ops := make([]bakery.Op, len(perms))
+	for i, p := range perms {
+		ops[i] = p.op()
+	}
+
+	authChecker := s.bakery.Checker.Auth(macaroon.Slice{mac})
+	_, err := authChecker.Allow(ctx, ops...)
+	return err
+}
+
+type ctxKey int
+
+const (
+	ctxKeyClientIP ctxKey = iota
+	ctxKeyPaymentMsat
+	ctxKeyMacaroon
+)
+
+// ContextWithClientIP attaches the caller's IP address so the ip-range
+// caveat checker can validate it. gRPC requests get this from peer.Context
+// automatically via the bakery library's own mechanisms in lnd, but since
+// this bridge terminates both gRPC and REST (through the Gin middleware)
+// itself, the IP is threaded through explicitly for both.
+func ContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ctxKeyClientIP, ip)
+}
+
+// ContextWithPaymentMsat attaches the millisatoshi amount a request is
+// asking to move, so a max-payment-msat caveat can enforce a macaroon's
+// spending limit. RPCs with no natural amount never set this; if the
+// macaroon still carries the caveat, it is treated as satisfied, since
+// there is nothing to compare against.
+func ContextWithPaymentMsat(ctx context.Context, amtMsat uint64) context.Context {
+	return context.WithValue(ctx, ctxKeyPaymentMsat, amtMsat)
+}
+
+// ContextWithMacaroon attaches the raw macaroon bytes a caller presented.
+// Authorization normally happens once, up front, but an RPC like PayInvoice
+// only learns its real amount after decoding the invoice inside its own
+// handler -- by which point the interceptor's Authorize call has already
+// run without one. Stashing the macaroon here lets that handler re-run
+// Authorize itself once the amount is known, with ContextWithPaymentMsat
+// set, so a max-payment-msat caveat actually gets enforced.
+func ContextWithMacaroon(ctx context.Context, macBytes []byte) context.Context {
+	return context.WithValue(ctx, ctxKeyMacaroon, macBytes)
+}
+
+// MacaroonFromContext returns the macaroon bytes ContextWithMacaroon
+// attached, if any.
+func MacaroonFromContext(ctx context.Context) ([]byte, bool) {
+	macBytes, ok := ctx.Value(ctxKeyMacaroon).([]byte)
+	return macBytes, ok
+}
+
+func checkIPRange(ctx context.Context, _, arg string) error {
+	_, cidr, err := net.ParseCIDR(arg)
+	if err != nil {
+		return fmt.Errorf("invalid ip-range caveat %q: %v", arg, err)
+	}
+	ipStr, _ := ctx.Value(ctxKeyClientIP).(string)
+	if ipStr == "" {
+		return fmt.Errorf("no client IP available to check ip-range caveat")
+	}
+	ip := net.ParseIP(ipStr)
+	if ip == nil || !cidr.Contains(ip) {
+		return fmt.Errorf("client IP %s outside macaroon ip-range %s", ipStr, arg)
+	}
+	return nil
+}
+
+func checkMaxPaymentMsat(ctx context.Context, _, arg string) error {
+	limit, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid max-payment-msat caveat %q: %v", arg, err)
+	}
+	amt, ok := ctx.Value(ctxKeyPaymentMsat).(uint64)
+	if !ok {
+		return nil
+	}
+	if amt > limit {
+		return fmt.Errorf("requested amount %d msat exceeds macaroon limit of %d msat", amt, limit)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package macaroons
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.etcd.io/bbolt"
+)
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "macaroons.db"), 0600, nil)
+	if err != nil {
+		t.Fatalf("cannot open test macaroon db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	svc, err := NewService(db, "test")
+	if err != nil {
+		t.Fatalf("cannot create service: %v", err)
+	}
+	return svc
+}
+
+var invoiceRead = []Permission{{Entity: EntityInvoice, Action: ActionRead}}
+var offchainWrite = []Permission{{Entity: EntityOffchain, Action: ActionWrite}}
+
+func bakeAndMarshal(t *testing.T, svc *Service, expiresAt time.Time, ipRange string, maxPaymentMsat uint64, perms ...Permission) []byte {
+	t.Helper()
+	mac, err := svc.Bake(context.Background(), expiresAt, ipRange, maxPaymentMsat, perms...)
+	if err != nil {
+		t.Fatalf("Bake returned unexpected error: %v", err)
+	}
+	data, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("cannot marshal macaroon: %v", err)
+	}
+	return data
+}
+
+func TestAuthorizeGrantsOnlyBakedPermissions(t *testing.T) {
+	svc := newTestService(t)
+	macBytes := bakeAndMarshal(t, svc, time.Time{}, "", 0, invoiceRead...)
+
+	if err := svc.Authorize(context.Background(), macBytes, invoiceRead...); err != nil {
+		t.Fatalf("Authorize rejected a macaroon for its own permission: %v", err)
+	}
+	if err := svc.Authorize(context.Background(), macBytes, offchainWrite...); err == nil {
+		t.Fatal("Authorize should reject a permission the macaroon wasn't baked with")
+	}
+}
+
+func TestAuthorizeEnforcesMaxPaymentMsat(t *testing.T) {
+	svc := newTestService(t)
+	macBytes := bakeAndMarshal(t, svc, time.Time{}, "", 1000, offchainWrite...)
+
+	if err := svc.Authorize(ContextWithPaymentMsat(context.Background(), 1000), macBytes, offchainWrite...); err != nil {
+		t.Fatalf("Authorize rejected a payment at the macaroon's limit: %v", err)
+	}
+	if err := svc.Authorize(ContextWithPaymentMsat(context.Background(), 1001), macBytes, offchainWrite...); err == nil {
+		t.Fatal("Authorize should reject a payment over the macaroon's max-payment-msat limit")
+	}
+	// No amount attached to the context at all: nothing to compare against,
+	// so the caveat is treated as satisfied rather than rejecting outright.
+	if err := svc.Authorize(context.Background(), macBytes, offchainWrite...); err != nil {
+		t.Fatalf("Authorize rejected a request with no payment amount attached: %v", err)
+	}
+}
+
+func TestAuthorizeEnforcesIPRange(t *testing.T) {
+	svc := newTestService(t)
+	macBytes := bakeAndMarshal(t, svc, time.Time{}, "10.0.0.0/24", 0, invoiceRead...)
+
+	if err := svc.Authorize(ContextWithClientIP(context.Background(), "10.0.0.5"), macBytes, invoiceRead...); err != nil {
+		t.Fatalf("Authorize rejected a client IP inside the macaroon's ip-range: %v", err)
+	}
+	if err := svc.Authorize(ContextWithClientIP(context.Background(), "192.168.1.5"), macBytes, invoiceRead...); err == nil {
+		t.Fatal("Authorize should reject a client IP outside the macaroon's ip-range")
+	}
+}
+
+func TestAuthorizeEnforcesExpiry(t *testing.T) {
+	svc := newTestService(t)
+	macBytes := bakeAndMarshal(t, svc, time.Now().Add(-time.Hour), "", 0, invoiceRead...)
+
+	if err := svc.Authorize(context.Background(), macBytes, invoiceRead...); err == nil {
+		t.Fatal("Authorize should reject an expired macaroon")
+	}
+}
+
+func TestContextWithMacaroonRoundTrips(t *testing.T) {
+	want := []byte{1, 2, 3}
+	ctx := ContextWithMacaroon(context.Background(), want)
+
+	got, ok := MacaroonFromContext(ctx)
+	if !ok {
+		t.Fatal("MacaroonFromContext returned ok=false after ContextWithMacaroon")
+	}
+	if string(got) != string(want) {
+		t.Fatalf("MacaroonFromContext = %v, want %v", got, want)
+	}
+
+	if _, ok := MacaroonFromContext(context.Background()); ok {
+		t.Fatal("MacaroonFromContext should return ok=false when nothing was attached")
+	}
+}
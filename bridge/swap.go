@@ -0,0 +1,508 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"go.etcd.io/bbolt"
+
+	"github.com/Maitreya-ZionNet/Zion-2.6-TestNet/bridge/pkg/amount"
+)
+
+// SwapState is the lifecycle stage of an atomic ZION<->Lightning swap.
+type SwapState string
+
+const (
+	SwapInitiated  SwapState = "initiated"
+	SwapHTLCLocked SwapState = "htlc_locked"
+	SwapRemotePaid SwapState = "remote_paid"
+	SwapSettled    SwapState = "settled"
+	SwapRefunded   SwapState = "refunded"
+	SwapFailed     SwapState = "failed"
+)
+
+// SwapDirection indicates which side of the swap originates the Lightning
+// leg: ln_to_zion is an incoming hold invoice that credits ZION on
+// acceptance, zion_to_ln reserves ZION first and then pays a Lightning
+// invoice.
+type SwapDirection string
+
+const (
+	SwapLNToZion SwapDirection = "ln_to_zion"
+	SwapZionToLN SwapDirection = "zion_to_ln"
+)
+
+// Swap is a single atomic ZION<->Lightning exchange, persisted so an
+// in-flight swap can be replayed if the bridge restarts mid-flight.
+type Swap struct {
+	ID          string        `json:"id"`
+	Direction   SwapDirection `json:"direction"`
+	State       SwapState     `json:"state"`
+	Invoice     string        `json:"invoice,omitempty"`
+	PaymentHash string        `json:"payment_hash"`
+	Preimage    string        `json:"preimage,omitempty"`
+	AmountSat   uint64        `json:"amount_sat"`
+	AmountZion  uint64        `json:"amount_zion"`
+	ZionAddress string        `json:"zion_address"`
+	Error       string        `json:"error,omitempty"`
+	CreatedAt   int64         `json:"created_at"`
+	UpdatedAt   int64         `json:"updated_at"`
+}
+
+var swapsBucket = []byte("swaps")
+
+// SwapEngine drives the two-phase swap state machine so the ZION credit/debit
+// and the Lightning settlement either both land or both unwind, instead of
+// the ZION side being fire-and-forget once the Lightning leg has gone
+// through. Every swap is persisted to bbolt and a recovery loop on Start
+// replays whatever was still in flight when the bridge last stopped.
+type SwapEngine struct {
+	bridge *ZionLightningBridge
+	db     *bbolt.DB
+
+	mu          sync.RWMutex
+	swaps       map[string]*Swap
+	subscribers map[chan *Swap]struct{}
+}
+
+// NewSwapEngine opens (creating if necessary) the bbolt swap store at
+// dbPath and loads any previously recorded swaps into memory.
+func NewSwapEngine(bridge *ZionLightningBridge, dbPath string) (*SwapEngine, error) {
+	db, err := bbolt.Open(dbPath, 0600, &bbolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("cannot open swap store: %v", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(swapsBucket)
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("cannot init swap bucket: %v", err)
+	}
+
+	se := &SwapEngine{
+		bridge:      bridge,
+		db:          db,
+		swaps:       make(map[string]*Swap),
+		subscribers: make(map[chan *Swap]struct{}),
+	}
+	if err := se.loadAll(); err != nil {
+		return nil, fmt.Errorf("cannot load swap store: %v", err)
+	}
+
+	return se, nil
+}
+
+func (se *SwapEngine) loadAll() error {
+	return se.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(swapsBucket).ForEach(func(k, v []byte) error {
+			var swap Swap
+			if err := json.Unmarshal(v, &swap); err != nil {
+				return err
+			}
+			se.swaps[swap.ID] = &swap
+			return nil
+		})
+	})
+}
+
+// Start replays every swap left in a non-terminal state across a restart,
+// re-querying LND for the invoice/payment status each one was waiting on.
+func (se *SwapEngine) Start(ctx context.Context) {
+	se.mu.RLock()
+	var inFlight []*Swap
+	for _, swap := range se.swaps {
+		switch swap.State {
+		case SwapSettled, SwapRefunded, SwapFailed:
+		default:
+			inFlight = append(inFlight, swap)
+		}
+	}
+	se.mu.RUnlock()
+
+	for _, swap := range inFlight {
+		log.Printf("Resuming in-flight swap %s (%s, state=%s)", swap.ID, swap.Direction, swap.State)
+		go se.recover(ctx, swap)
+	}
+}
+
+func (se *SwapEngine) recover(ctx context.Context, swap *Swap) {
+	hash, err := lntypes.MakeHashFromStr(swap.PaymentHash)
+	if err != nil {
+		log.Printf("Warning: cannot recover swap %s: %v", swap.ID, err)
+		return
+	}
+
+	switch swap.Direction {
+	case SwapLNToZion:
+		preimage, err := lntypes.MakePreimageFromStr(swap.Preimage)
+		if err != nil {
+			log.Printf("Warning: cannot recover swap %s: %v", swap.ID, err)
+			return
+		}
+		inv, err := se.bridge.lnd.Client.LookupInvoice(ctx, hash)
+		if err != nil {
+			log.Printf("Warning: cannot look up invoice for swap %s: %v", swap.ID, err)
+			return
+		}
+		switch inv.State {
+		case invoices.ContractAccepted:
+			se.settleLNToZion(ctx, swap, preimage)
+		case invoices.ContractSettled:
+			swap.State = SwapSettled
+			se.save(swap)
+		case invoices.ContractCanceled:
+			swap.State = SwapFailed
+			swap.Error = "invoice canceled"
+			se.save(swap)
+		default:
+			se.watchLNToZion(ctx, swap, preimage)
+		}
+
+	case SwapZionToLN:
+		statusCh, errCh, err := se.bridge.lnd.Router.TrackPayment(ctx, hash)
+		if err != nil {
+			se.releaseZionToLN(swap, err)
+			return
+		}
+		se.watchZionToLNPayment(swap, statusCh, errCh)
+	}
+}
+
+// Get returns a tracked swap by ID.
+func (se *SwapEngine) Get(id string) (*Swap, bool) {
+	se.mu.RLock()
+	defer se.mu.RUnlock()
+	swap, ok := se.swaps[id]
+	return swap, ok
+}
+
+// List returns every tracked swap, most recently created first.
+func (se *SwapEngine) List() []*Swap {
+	se.mu.RLock()
+	defer se.mu.RUnlock()
+	out := make([]*Swap, 0, len(se.swaps))
+	for _, swap := range se.swaps {
+		out = append(out, swap)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt > out[j].CreatedAt })
+	return out
+}
+
+func (se *SwapEngine) save(swap *Swap) {
+	swap.UpdatedAt = time.Now().Unix()
+
+	se.mu.Lock()
+	se.swaps[swap.ID] = swap
+	se.mu.Unlock()
+
+	data, err := json.Marshal(swap)
+	if err != nil {
+		log.Printf("Warning: cannot marshal swap %s: %v", swap.ID, err)
+		return
+	}
+	err = se.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(swapsBucket).Put([]byte(swap.ID), data)
+	})
+	if err != nil {
+		log.Printf("Warning: cannot persist swap %s: %v", swap.ID, err)
+	}
+
+	se.broadcast(swap)
+}
+
+// Subscribe registers a channel that receives every swap state change as
+// it's persisted, for streaming RPCs such as SubscribeSwaps. The returned
+// func unregisters it; callers must call it when done to avoid leaking the
+// channel.
+func (se *SwapEngine) Subscribe() (<-chan *Swap, func()) {
+	ch := make(chan *Swap, 16)
+
+	se.mu.Lock()
+	se.subscribers[ch] = struct{}{}
+	se.mu.Unlock()
+
+	unsubscribe := func() {
+		se.mu.Lock()
+		delete(se.subscribers, ch)
+		se.mu.Unlock()
+	}
+	return ch, unsubscribe
+}
+
+func (se *SwapEngine) broadcast(swap *Swap) {
+	se.mu.RLock()
+	defer se.mu.RUnlock()
+
+	for ch := range se.subscribers {
+		select {
+		case ch <- swap:
+		default:
+			log.Printf("Warning: swap subscriber channel full, dropping update for %s", swap.ID)
+		}
+	}
+}
+
+// InitiateLNToZion opens a hold invoice for amountSat. The HTLC is only
+// settled - crediting the payer's ZION address - once the ZION-side credit
+// has actually gone through; if it fails, the hold invoice is canceled so
+// the Lightning payer is refunded instead of us keeping their funds.
+func (se *SwapEngine) InitiateLNToZion(ctx context.Context, amountSat uint64, zionAddress, memo string) (*Swap, error) {
+	var preimageBytes [32]byte
+	if _, err := rand.Read(preimageBytes[:]); err != nil {
+		return nil, fmt.Errorf("cannot generate preimage: %v", err)
+	}
+	preimage, err := lntypes.MakePreimage(preimageBytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate preimage: %v", err)
+	}
+	hash := preimage.Hash()
+
+	amtZion, err := se.bridge.ConvertSatToZion(ctx, amountSat)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := se.bridge.lnd.Invoices.AddHoldInvoice(ctx, &invoicesrpc.AddInvoiceData{
+		Memo:  memo,
+		Hash:  &hash,
+		Value: lnwire.NewMSatFromSatoshis(btcutil.Amount(amountSat)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create hold invoice: %v", err)
+	}
+
+	swap := &Swap{
+		ID:          hash.String(),
+		Direction:   SwapLNToZion,
+		State:       SwapInitiated,
+		Invoice:     invoice,
+		PaymentHash: hash.String(),
+		Preimage:    preimage.String(),
+		AmountSat:   amountSat,
+		AmountZion:  amtZion,
+		ZionAddress: zionAddress,
+		CreatedAt:   time.Now().Unix(),
+	}
+	se.save(swap)
+
+	// watchLNToZion outlives this call -- AddHoldInvoice has already
+	// returned, but the HTLC itself won't be accepted/settled until some
+	// point after that. Run it against a context detached from ctx's
+	// cancellation, since ctx here is the inbound RPC's context and gRPC
+	// cancels it the instant this function returns.
+	go se.watchLNToZion(context.WithoutCancel(ctx), swap, preimage)
+
+	return swap, nil
+}
+
+func (se *SwapEngine) watchLNToZion(ctx context.Context, swap *Swap, preimage lntypes.Preimage) {
+	hash := preimage.Hash()
+	updates, errs, err := se.bridge.lnd.Invoices.SubscribeSingleInvoice(ctx, hash)
+	if err != nil {
+		swap.State = SwapFailed
+		swap.Error = err.Error()
+		se.save(swap)
+		return
+	}
+
+	for {
+		select {
+		case update, ok := <-updates:
+			if !ok {
+				return
+			}
+			switch update.State {
+			case invoices.ContractAccepted:
+				se.settleLNToZion(ctx, swap, preimage)
+				return
+			case invoices.ContractCanceled:
+				swap.State = SwapFailed
+				swap.Error = "invoice canceled"
+				se.save(swap)
+				return
+			}
+		case err := <-errs:
+			if err != nil {
+				log.Printf("Warning: swap %s invoice subscription error: %v", swap.ID, err)
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (se *SwapEngine) settleLNToZion(ctx context.Context, swap *Swap, preimage lntypes.Preimage) {
+	swap.State = SwapHTLCLocked
+	se.save(swap)
+
+	if err := se.bridge.zionRPC.SendTransaction("lightning_pool_address", swap.ZionAddress, swap.AmountZion); err != nil {
+		log.Printf("Warning: ZION credit failed for swap %s, canceling hold invoice: %v", swap.ID, err)
+		hash := preimage.Hash()
+		if cancelErr := se.bridge.lnd.Invoices.CancelInvoice(ctx, hash); cancelErr != nil {
+			log.Printf("Warning: cannot cancel hold invoice for swap %s: %v", swap.ID, cancelErr)
+		}
+		swap.State = SwapRefunded
+		swap.Error = err.Error()
+		se.save(swap)
+		return
+	}
+
+	if err := se.bridge.lnd.Invoices.SettleInvoice(ctx, preimage); err != nil {
+		log.Printf("Warning: cannot settle hold invoice for swap %s: %v", swap.ID, err)
+		swap.State = SwapFailed
+		swap.Error = err.Error()
+		se.save(swap)
+		return
+	}
+
+	swap.State = SwapSettled
+	se.save(swap)
+}
+
+// InitiateZionToLN reserves the invoice's amount worth of ZION for zionAddress before
+// attempting the Lightning payment, so a failed payment releases the
+// reservation instead of leaving the ZION side already spent. The caller's
+// macaroon is re-authorized here, with the invoice's actual amount attached,
+// since that amount isn't known until the invoice is decoded -- after the
+// gRPC interceptor's own, amount-blind authorization pass already ran.
+func (se *SwapEngine) InitiateZionToLN(ctx context.Context, invoice, zionAddress string) (*Swap, error) {
+	payReq, err := se.bridge.lnd.Client.DecodePaymentRequest(ctx, invoice)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode invoice: %v", err)
+	}
+
+	if err := authorizeAmount(ctx, se.bridge.macaroonSvc, uint64(payReq.Value),
+		bridgeRPCPermissions["/zionbridgerpc.ZionBridge/PayInvoice"]...); err != nil {
+		return nil, err
+	}
+
+	amtSatTyped, err := amount.Msat(payReq.Value).ToSat()
+	if err != nil {
+		return nil, fmt.Errorf("invoice amount cannot be paid from ZION: %v", err)
+	}
+	amtSat := uint64(amtSatTyped)
+
+	amtZion, err := se.bridge.ConvertSatToZion(ctx, amtSat)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := se.bridge.zionRPC.GetBalance(zionAddress)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get ZION balance: %v", err)
+	}
+	if balance < amtZion {
+		return nil, fmt.Errorf("insufficient ZION balance: %d < %d", balance, amtZion)
+	}
+
+	if err := se.bridge.zionRPC.ReserveBalance(zionAddress, amtZion); err != nil {
+		return nil, fmt.Errorf("cannot reserve ZION balance: %v", err)
+	}
+
+	swap := &Swap{
+		ID:          payReq.Hash.String(),
+		Direction:   SwapZionToLN,
+		State:       SwapInitiated,
+		Invoice:     invoice,
+		PaymentHash: payReq.Hash.String(),
+		AmountSat:   amtSat,
+		AmountZion:  amtZion,
+		ZionAddress: zionAddress,
+		CreatedAt:   time.Now().Unix(),
+	}
+	se.save(swap)
+
+	// Same reasoning as watchLNToZion above: payZionToLN tracks the payment
+	// long after PayInvoice has returned and gRPC has canceled ctx, so it
+	// needs a context that survives that.
+	go se.payZionToLN(context.WithoutCancel(ctx), swap, invoice)
+
+	return swap, nil
+}
+
+func (se *SwapEngine) payZionToLN(ctx context.Context, swap *Swap, invoice string) {
+	swap.State = SwapHTLCLocked
+	se.save(swap)
+
+	maxFee := btcutil.Amount(swap.AmountSat) / 100
+	if maxFee == 0 {
+		maxFee = 1
+	}
+
+	statusCh, errCh, err := se.bridge.lnd.Router.SendPayment(ctx, lndclient.SendPaymentRequest{
+		Invoice: invoice,
+		MaxFee:  maxFee,
+		Timeout: 60 * time.Second,
+	})
+	if err != nil {
+		se.releaseZionToLN(swap, err)
+		return
+	}
+
+	se.watchZionToLNPayment(swap, statusCh, errCh)
+}
+
+func (se *SwapEngine) watchZionToLNPayment(swap *Swap, statusCh chan lndclient.PaymentStatus, errCh chan error) {
+	for {
+		select {
+		case status, ok := <-statusCh:
+			if !ok {
+				return
+			}
+			switch status.State {
+			case lnrpc.Payment_SUCCEEDED:
+				se.finalizeZionToLN(swap, status)
+				return
+			case lnrpc.Payment_FAILED:
+				se.releaseZionToLN(swap, fmt.Errorf("payment failed: %s", status.FailureReason))
+				return
+			}
+		case err := <-errCh:
+			if err != nil {
+				se.releaseZionToLN(swap, err)
+			}
+			return
+		}
+	}
+}
+
+func (se *SwapEngine) finalizeZionToLN(swap *Swap, status lndclient.PaymentStatus) {
+	swap.State = SwapRemotePaid
+	swap.Preimage = status.Preimage.String()
+	se.save(swap)
+
+	if err := se.bridge.zionRPC.FinalizeReservation(swap.ZionAddress, swap.AmountZion); err != nil {
+		log.Printf("Warning: cannot finalize ZION debit for swap %s: %v", swap.ID, err)
+		swap.State = SwapFailed
+		swap.Error = err.Error()
+		se.save(swap)
+		return
+	}
+
+	swap.State = SwapSettled
+	se.save(swap)
+}
+
+func (se *SwapEngine) releaseZionToLN(swap *Swap, cause error) {
+	if err := se.bridge.zionRPC.ReleaseReservation(swap.ZionAddress, swap.AmountZion); err != nil {
+		log.Printf("Warning: cannot release ZION reservation for swap %s: %v", swap.ID, err)
+	}
+	swap.State = SwapRefunded
+	swap.Error = cause.Error()
+	se.save(swap)
+}